@@ -0,0 +1,242 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"github.com/ccoveille/go-safecast/v2"
+	"kreklow.us/go/go-adsb/adsbtype"
+)
+
+// ACAS is the air-to-air surveillance information reported in DF0 and
+// DF16 replies.
+//
+// Reply information values 0 to 7 describe the ACAS capability, values
+// 8 to 15 describe the maximum cruising airspeed. A SpeedMax of 0 with
+// SpeedValid set indicates an airspeed greater than SpeedMin.
+type ACAS struct {
+	VS adsbtype.VS // vertical status
+	SL adsbtype.SL // sensitivity level
+	CC adsbtype.CC // crosslink capability, DF0 only
+	RI adsbtype.RI // reply information
+
+	Operational bool // ACAS is operational
+	Installed   bool // ACAS with resolution capability is installed
+	Inhibited   bool // resolution capability is inhibited
+	Vertical    bool // vertical resolution capability
+	Horizontal  bool // horizontal resolution capability
+
+	SpeedMin   int64 // minimum of the maximum airspeed class in knots
+	SpeedMax   int64 // maximum of the maximum airspeed class in knots
+	SpeedValid bool  // maximum airspeed class is available
+
+	RA *RA // resolution advisory, DF16 only
+}
+
+// speedTbl contains the maximum airspeed classes for reply information
+// values 9 to 14.
+var speedTbl = map[uint64][]int64{
+	9:  {0, 75},
+	10: {75, 150},
+	11: {150, 300},
+	12: {300, 600},
+	13: {600, 1200},
+	14: {1200, 0},
+}
+
+// ACAS returns the air-to-air surveillance information from a DF0 or
+// DF16 reply. For DF16 replies containing Comm-B register 3,0 in the MV
+// field, the resolution advisory is also decoded.
+func (m *Message) ACAS() (*ACAS, error) {
+	df, err := m.raw.DF()
+	if err != nil {
+		return nil, newError(err, "error retrieving ACAS")
+	}
+
+	if df != 0 && df != 16 {
+		return nil, newError(ErrNotAvailable, "error retrieving ACAS")
+	}
+
+	vs, _ := m.raw.VS()
+	sl, _ := m.raw.SL()
+	ri, _ := m.raw.RI()
+
+	a := new(ACAS)
+	a.VS = adsbtype.VS(vs)
+	a.SL = adsbtype.SL(sl)
+	a.RI = adsbtype.RI(ri)
+	a.Operational = sl != 0
+	a.Installed = ri >= 2 && ri <= 4
+	a.Inhibited = ri == 2
+	a.Vertical = ri == 3 || ri == 4
+	a.Horizontal = ri == 4
+
+	if spd, ok := speedTbl[ri]; ok {
+		a.SpeedMin = spd[0]
+		a.SpeedMax = spd[1]
+		a.SpeedValid = true
+	}
+
+	if df == 0 {
+		cc, _ := m.raw.CC()
+		a.CC = adsbtype.CC(cc)
+
+		return a, nil
+	}
+
+	mv, _ := m.raw.MV()
+	if fieldBits(mv, 1, 8) == 0x30 {
+		a.RA = decodeRA(mv)
+	}
+
+	return a, nil
+}
+
+// RA is an ACAS resolution advisory report, as broadcast in extended
+// squitter type code 28 subtype 2, in Comm-B register 3,0 and in the MV
+// field of DF16 replies.
+//
+// The ARA field is interpreted for a single threat when bit 41 of the
+// field is set, or for multiple threats when bit 41 is clear and MTE is
+// set. Upward and Downward may both be set when multiple threats require
+// corrections in opposite directions.
+type RA struct {
+	ARA uint16 // active resolution advisories
+	RAC uint8  // resolution advisory complements
+	RAT bool   // resolution advisory terminated
+	MTE bool   // multiple threat encounter
+	TTI uint8  // threat type indicator
+
+	Active        bool // a resolution advisory is active
+	Corrective    bool // corrective rather than preventive
+	Upward        bool // upward sense
+	Downward      bool // downward sense
+	IncreasedRate bool // increased rate
+	SenseReversal bool // sense reversal
+	Crossing      bool // altitude crossing
+	Positive      bool // positive climb or descend rather than vertical speed limit
+
+	NoPassBelow bool // do not pass below
+	NoPassAbove bool // do not pass above
+	NoTurnLeft  bool // do not turn left
+	NoTurnRight bool // do not turn right
+
+	ThreatAddr    uint64  // threat Mode S address, TTI 1
+	ThreatAlt     int64   // threat altitude in feet, TTI 2
+	AltValid      bool    // threat altitude is available
+	ThreatRange   float64 // threat range in NM, TTI 2
+	RangeValid    bool    // threat range is available
+	ThreatBearing float64 // threat bearing in degrees, TTI 2
+	BearingValid  bool    // threat bearing is available
+}
+
+// RA returns the resolution advisory broadcast in an extended squitter
+// type code 28 subtype 2 message.
+func (m *Message) RA() (*RA, error) {
+	tc, err := m.raw.ESType()
+	if err != nil {
+		return nil, newError(err, "error retrieving resolution advisory")
+	}
+
+	if tc != 28 {
+		return nil, newError(ErrNotAvailable, "error retrieving resolution advisory")
+	}
+
+	st, _ := m.raw.ESSubtype()
+	if st != 2 {
+		return nil, newErrorf(ErrNotAvailable,
+			"error retrieving resolution advisory from subtype %d", st)
+	}
+
+	me, err := m.raw.ME()
+	if err != nil {
+		return nil, newError(err, "error retrieving resolution advisory")
+	}
+
+	return decodeRA(me), nil
+}
+
+// araBit returns the mask for ARA bit n, numbered 41 to 54 as in the
+// ACAS specifications.
+func araBit(n int) uint16 {
+	return 1 << (54 - n)
+}
+
+// decodeRA decodes a resolution advisory from a 56 bit message field.
+func decodeRA(f uint64) *RA {
+	ra := new(RA)
+	ra.ARA = safecast.MustConvert[uint16](fieldBits(f, 9, 22))
+	ra.RAC = safecast.MustConvert[uint8](fieldBits(f, 23, 26))
+	ra.RAT = fieldBits(f, 27, 27) == 1
+	ra.MTE = fieldBits(f, 28, 28) == 1
+	ra.TTI = safecast.MustConvert[uint8](fieldBits(f, 29, 30))
+
+	bit := func(n int) bool { return ra.ARA&araBit(n) != 0 }
+
+	switch {
+	case bit(41):
+		ra.Active = true
+		ra.Corrective = bit(42)
+		ra.Downward = bit(43)
+		ra.Upward = !bit(43)
+		ra.IncreasedRate = bit(44)
+		ra.SenseReversal = bit(45)
+		ra.Crossing = bit(46)
+		ra.Positive = bit(47)
+	case ra.MTE:
+		ra.Active = true
+		ra.Corrective = bit(42) || bit(44)
+		ra.Upward = bit(42) || bit(43)
+		ra.Downward = bit(44) || bit(45)
+		ra.Positive = bit(43) || bit(45)
+		ra.Crossing = bit(46)
+		ra.SenseReversal = bit(47)
+	}
+
+	ra.NoPassBelow = ra.RAC&0b1000 != 0
+	ra.NoPassAbove = ra.RAC&0b0100 != 0
+	ra.NoTurnLeft = ra.RAC&0b0010 != 0
+	ra.NoTurnRight = ra.RAC&0b0001 != 0
+
+	switch ra.TTI {
+	case 1:
+		ra.ThreatAddr = fieldBits(f, 31, 54)
+	case 2:
+		alt, err := decodeAC(fieldBits(f, 31, 43))
+		if err == nil {
+			ra.ThreatAlt = alt.Feet
+			ra.AltValid = true
+		}
+
+		if r := fieldBits(f, 44, 50); r != 0 {
+			ra.ThreatRange = float64(r-1) / 10
+			ra.RangeValid = true
+		}
+
+		if b := fieldBits(f, 51, 56); b != 0 && b <= 60 {
+			ra.ThreatBearing = float64(b-1) * 6
+			ra.BearingValid = true
+		}
+	}
+
+	return ra
+}
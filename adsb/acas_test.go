@@ -0,0 +1,203 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb_test
+
+import (
+	"errors"
+	"testing"
+
+	"kreklow.us/go/go-adsb/adsb"
+	"kreklow.us/go/go-adsb/adsbtype"
+)
+
+// TestRA runs the test cases for resolution advisory decoding.
+func TestRA(t *testing.T) {
+	t.Run("SingleThreat", testRASingle)
+	t.Run("MultipleThreat", testRAMultiple)
+	t.Run("Subtype", testRASubtype)
+}
+
+// test a corrective climb against a threat with range and bearing.
+func testRASingle(t *testing.T) {
+	testRA(t, "8d4ca123e2c2000ae30690d89075", &adsb.RA{
+		ARA:           0x3080,
+		TTI:           2,
+		Active:        true,
+		Corrective:    true,
+		Upward:        true,
+		Positive:      true,
+		ThreatAlt:     36000,
+		AltValid:      true,
+		ThreatRange:   2.5,
+		RangeValid:    true,
+		ThreatBearing: 90,
+		BearingValid:  true,
+	})
+}
+
+// test a terminated multiple threat advisory with a threat address.
+func testRAMultiple(t *testing.T) {
+	testRA(t, "8d4ca123e2480276af37bcc1752c", &adsb.RA{
+		ARA:         0x1200,
+		RAC:         0b1001,
+		RAT:         true,
+		MTE:         true,
+		TTI:         1,
+		Active:      true,
+		Corrective:  true,
+		Upward:      true,
+		Downward:    true,
+		Positive:    true,
+		NoPassBelow: true,
+		NoTurnRight: true,
+		ThreatAddr:  0xabcdef,
+	})
+}
+
+func testRA(t *testing.T, msg string, exp *adsb.RA) {
+	t.Helper()
+
+	m := testMsg(t, msg)
+
+	ra, err := m.RA()
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	if *ra != *exp {
+		t.Errorf("received %+v, expected %+v", *ra, *exp)
+	}
+}
+
+// test an emergency status message.
+func testRASubtype(t *testing.T) {
+	m := testMsg(t, "8d4ca123e1aaa200000000fc68dc")
+
+	ra, err := m.RA()
+	if !errors.Is(err, adsb.ErrNotAvailable) {
+		t.Error("expected ErrNotAvailable, received", err)
+	}
+
+	if ra != nil {
+		t.Error("received unexpected data")
+	}
+}
+
+// TestACAS runs the test cases for DF0 and DF16 ACAS decoding.
+func TestACAS(t *testing.T) {
+	t.Run("Capability", testACASCapability)
+	t.Run("Airspeed", testACASAirspeed)
+	t.Run("Resolution", testACASResolution)
+	t.Run("NotAvailable", testACASNotAvailable)
+}
+
+// test reply information describing ACAS capability.
+func testACASCapability(t *testing.T) {
+	for msg, exp := range map[string]adsb.ACAS{
+		"8400191012345678000000b76cbe": {
+			VS: adsbtype.VS1, RI: adsbtype.RI0,
+		},
+		"02e19718e70f6c": {
+			SL: adsbtype.SL7, CC: adsbtype.CC1, RI: adsbtype.RI3, Operational: true,
+			Installed: true, Vertical: true,
+		},
+	} {
+		testACAS(t, msg, &exp)
+	}
+}
+
+// test reply information describing maximum airspeed.
+func testACASAirspeed(t *testing.T) {
+	for msg, exp := range map[string]adsb.ACAS{
+		"02e619106b1741": {
+			SL: adsbtype.SL7, CC: adsbtype.CC1, RI: adsbtype.RI12,
+			Operational: true, SpeedMin: 300, SpeedMax: 600, SpeedValid: true,
+		},
+		"004719109e4122": {
+			SL: adsbtype.SL2, RI: adsbtype.RI14, Operational: true,
+			SpeedMin: 1200, SpeedValid: true,
+		},
+		"00441910883de1": {
+			SL: adsbtype.SL2, RI: adsbtype.RI8, Operational: true,
+		},
+	} {
+		testACAS(t, msg, &exp)
+	}
+}
+
+func testACAS(t *testing.T, msg string, exp *adsb.ACAS) {
+	t.Helper()
+
+	m := testMsg(t, msg)
+
+	a, err := m.ACAS()
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	if *a != *exp {
+		t.Errorf("%s: received %+v, expected %+v", msg, *a, *exp)
+	}
+}
+
+// test a DF16 reply containing a resolution advisory, which must decode
+// the same as the equivalent extended squitter broadcast.
+func testACASResolution(t *testing.T) {
+	m := testMsg(t, "80c2191030c2000ae3069015c89b")
+
+	a, err := m.ACAS()
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	if a.SL != adsbtype.SL6 || a.RI != adsbtype.RI4 || !a.Horizontal {
+		t.Errorf("received %+v", *a)
+	}
+
+	if a.RA == nil {
+		t.Fatal("received nil, expected resolution advisory")
+	}
+
+	ra, err := testMsg(t, "8d4ca123e2c2000ae30690d89075").RA()
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	if *a.RA != *ra {
+		t.Errorf("received %+v, expected %+v", *a.RA, *ra)
+	}
+}
+
+// test a message without ACAS information.
+func testACASNotAvailable(t *testing.T) {
+	m := testMsg(t, "20001910bc45e9")
+
+	a, err := m.ACAS()
+	if !errors.Is(err, adsb.ErrNotAvailable) {
+		t.Error("expected ErrNotAvailable, received", err)
+	}
+
+	if a != nil {
+		t.Error("received unexpected data")
+	}
+}
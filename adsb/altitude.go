@@ -22,16 +22,50 @@
 
 package adsb
 
-import "github.com/ccoveille/go-safecast/v2"
+import (
+	"math"
+
+	"github.com/ccoveille/go-safecast/v2"
+	"kreklow.us/go/go-adsb/adsbtype"
+)
+
+// feetPerMeter is used to convert metric altitudes to feet.
+const feetPerMeter = 3.28084
+
+// Altitude is a decoded altitude along with the units and encoding in
+// which it was reported.
+type Altitude struct {
+	Value    int64           // altitude in the reported units
+	Feet     int64           // altitude converted to feet
+	Metric   bool            // Value is in meters rather than feet
+	Encoding adsbtype.AltEnc // encoding of the altitude field
+	Type     adsbtype.ATS    // barometric altitude or GNSS height
+}
+
+// newMetricAlt returns an Altitude for a value in meters.
+func newMetricAlt(m uint64, t adsbtype.ATS) *Altitude {
+	v := safecast.MustConvert[int64](m)
 
-// decodeAC decodes the Altitude Code field to an altitude in feet.
-func decodeAC(a uint64) (int64, error) {
+	return &Altitude{
+		Value:    v,
+		Feet:     int64(math.Round(float64(v) * feetPerMeter)),
+		Metric:   true,
+		Encoding: adsbtype.AltEnc2,
+		Type:     t,
+	}
+}
+
+// decodeAC decodes the Altitude Code field.
+func decodeAC(a uint64) (*Altitude, error) {
 	if a == 0 || a&0xffffffffffffe000 != 0 {
-		return 0, newError(nil, "invalid altitude data")
+		return nil, newError(nil, "invalid altitude data")
 	}
 
 	if a&0b0000001000000 != 0 { // M bit designates feet vs meters
-		return 0, newError(nil, "metric altitude not supported")
+		// remove M bit, 1 meter increments
+		a = ((a & 0b1111110000000) >> 1) | (a & 0b0000000111111)
+
+		return newMetricAlt(a, adsbtype.ATS0), nil
 	}
 
 	if a&0b0000000010000 == 0 { // Q bit designates 100 ft vs 25 ft increments
@@ -42,7 +76,7 @@ func decodeAC(a uint64) (int64, error) {
 			((a & 0b0000100000000) >> 8)) // C4(24)
 
 		if h == 0 || h == 5 || h == 6 || h > 7 {
-			return 0, newError(nil, "invalid altitude value")
+			return nil, newError(nil, "invalid altitude value")
 		}
 
 		if h == 7 {
@@ -64,7 +98,9 @@ func decodeAC(a uint64) (int64, error) {
 			h = 6 - h
 		}
 
-		return safecast.MustConvert[int64]((f*500)+(h*100)) - 1300, nil
+		alt := safecast.MustConvert[int64]((f*500)+(h*100)) - 1300
+
+		return &Altitude{Value: alt, Feet: alt, Encoding: adsbtype.AltEnc1}, nil
 	}
 
 	// must be an 11 bit altitude
@@ -72,14 +108,15 @@ func decodeAC(a uint64) (int64, error) {
 		((a & 0b0000000100000) >> 1) |
 		(a & 0b0000000001111)
 
-	return safecast.MustConvert[int64](a*25) - 1000, nil
+	alt := safecast.MustConvert[int64](a*25) - 1000
+
+	return &Altitude{Value: alt, Feet: alt, Encoding: adsbtype.AltEnc0}, nil
 }
 
-// decodeESAlt decodes the extended squitter Altitude field to an
-// altitude feet.
-func decodeESAlt(a uint64) (int64, error) {
+// decodeESAlt decodes the extended squitter Altitude field.
+func decodeESAlt(a uint64) (*Altitude, error) {
 	if a == 0 || a&0xfffffffffffff000 != 0 {
-		return 0, newError(nil, "invalid altitude data")
+		return nil, newError(nil, "invalid altitude data")
 	}
 
 	// insert M bit
@@ -88,6 +125,16 @@ func decodeESAlt(a uint64) (int64, error) {
 	return decodeAC(a)
 }
 
+// decodeGNSSAlt decodes the extended squitter Altitude field containing
+// GNSS height in meters.
+func decodeGNSSAlt(a uint64) (*Altitude, error) {
+	if a == 0 || a&0xfffffffffffff000 != 0 {
+		return nil, newError(nil, "invalid altitude data")
+	}
+
+	return newMetricAlt(a, adsbtype.ATS1), nil
+}
+
 // grayDecode converts a value in "reflected binary code" aka "Gray
 // code" to a decimal value.
 func grayDecode(b uint64) uint64 {
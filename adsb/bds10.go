@@ -0,0 +1,109 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"github.com/ccoveille/go-safecast/v2"
+	"kreklow.us/go/go-adsb/adsbtype"
+)
+
+// DataLinkCap is the data link capability report from Comm-B register
+// 1,0.
+type DataLinkCap struct {
+	Continuation bool // register 1,1 contains further capability data
+	Overlay      bool // overlay command capability
+
+	ACAS        bool  // ACAS is operational
+	ACASHybrid  bool  // ACAS hybrid surveillance capability
+	ACASRA      bool  // ACAS generates resolution advisories as well as traffic advisories
+	ACASVersion uint8 // ACAS version (0 DO-185, 1 DO-185A, 2 DO-185B)
+
+	SubnetVersion    uint8  // Mode S subnetwork version, 0 if not available
+	Level5           bool   // level 5 transponder
+	SpecificServices bool   // Mode S specific services capability
+	UplinkELM        uint8  // uplink ELM average throughput capability
+	DownlinkELM      uint8  // downlink ELM throughput capability
+	AircraftID       bool   // aircraft identification capability
+	Squitter         bool   // extended squitter capability
+	SIC              bool   // surveillance identifier code capability
+	GICBToggle       bool   // toggled whenever register 1,7 changes
+	DTE              uint16 // data terminal equipment status
+}
+
+// gicbTbl contains the register reported by each capability bit of
+// Comm-B register 1,7, beginning with bit 1.
+var gicbTbl = []adsbtype.BDS{
+	adsbtype.BDS05, adsbtype.BDS06, adsbtype.BDS07, adsbtype.BDS08,
+	adsbtype.BDS09, adsbtype.BDS0A, adsbtype.BDS20, adsbtype.BDS21,
+	adsbtype.BDS40, adsbtype.BDS41, adsbtype.BDS42, adsbtype.BDS43,
+	adsbtype.BDS44, adsbtype.BDS45, adsbtype.BDS48, adsbtype.BDS50,
+	adsbtype.BDS51, adsbtype.BDS52, adsbtype.BDS53, adsbtype.BDS54,
+	adsbtype.BDS55, adsbtype.BDS56, adsbtype.BDS5F, adsbtype.BDS60,
+}
+
+// DecodeBDS10 decodes the data link capability report from the MB
+// field of a reply containing Comm-B register 1,0.
+func DecodeBDS10(mb uint64) (*DataLinkCap, error) {
+	if checkBDS10(mb) == 0 {
+		return nil, newError(nil, "error decoding register 1,0: invalid data")
+	}
+
+	c := new(DataLinkCap)
+	c.Continuation = fieldBits(mb, 9, 9) == 1
+	c.Overlay = fieldBits(mb, 15, 15) == 1
+	c.ACAS = fieldBits(mb, 16, 16) == 1
+	c.SubnetVersion = safecast.MustConvert[uint8](fieldBits(mb, 17, 23))
+	c.Level5 = fieldBits(mb, 24, 24) == 1
+	c.SpecificServices = fieldBits(mb, 25, 25) == 1
+	c.UplinkELM = safecast.MustConvert[uint8](fieldBits(mb, 26, 28))
+	c.DownlinkELM = safecast.MustConvert[uint8](fieldBits(mb, 29, 32))
+	c.AircraftID = fieldBits(mb, 33, 33) == 1
+	c.Squitter = fieldBits(mb, 34, 34) == 1
+	c.SIC = fieldBits(mb, 35, 35) == 1
+	c.GICBToggle = fieldBits(mb, 36, 36) == 1
+	c.ACASHybrid = fieldBits(mb, 37, 37) == 1
+	c.ACASRA = fieldBits(mb, 38, 38) == 1
+	c.ACASVersion = safecast.MustConvert[uint8](fieldBits(mb, 39, 40))
+	c.DTE = safecast.MustConvert[uint16](fieldBits(mb, 41, 56))
+
+	return c, nil
+}
+
+// DecodeBDS17 decodes the common usage GICB capability report from the
+// MB field of a reply containing Comm-B register 1,7, returning the
+// registers which the aircraft supports.
+func DecodeBDS17(mb uint64) ([]adsbtype.BDS, error) {
+	if checkBDS17(mb) == 0 {
+		return nil, newError(nil, "error decoding register 1,7: invalid data")
+	}
+
+	regs := make([]adsbtype.BDS, 0, len(gicbTbl))
+
+	for i, bds := range gicbTbl {
+		if fieldBits(mb, i+1, i+1) == 1 {
+			regs = append(regs, bds)
+		}
+	}
+
+	return regs, nil
+}
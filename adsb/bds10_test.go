@@ -0,0 +1,90 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb_test
+
+import (
+	"slices"
+	"testing"
+
+	"kreklow.us/go/go-adsb/adsb"
+	"kreklow.us/go/go-adsb/adsbtype"
+)
+
+// TestBDS10 runs the test cases for register 1,0 decoding.
+func TestBDS10(t *testing.T) {
+	c, err := adsb.DecodeBDS10(testMB(t, "a800178d10010080f50000d5893c"))
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	exp := adsb.DataLinkCap{
+		ACAS:             true,
+		ACASRA:           true,
+		ACASVersion:      1,
+		SpecificServices: true,
+		AircraftID:       true,
+		Squitter:         true,
+		SIC:              true,
+		GICBToggle:       true,
+	}
+
+	if *c != exp {
+		t.Errorf("received %+v, expected %+v", *c, exp)
+	}
+
+	c, err = adsb.DecodeBDS10(testMB(t, "a000083e202cc371c31de0aa1ccf"))
+	if err == nil || err.Error() != "error decoding register 1,0: invalid data" {
+		t.Error("received unexpected error", err)
+	}
+
+	if c != nil {
+		t.Error("received unexpected data")
+	}
+}
+
+// TestBDS17 runs the test cases for register 1,7 decoding.
+func TestBDS17(t *testing.T) {
+	regs, err := adsb.DecodeBDS17(testMB(t, "a0000638fa81c10000000081a92f"))
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	exp := []adsbtype.BDS{
+		adsbtype.BDS05, adsbtype.BDS06, adsbtype.BDS07, adsbtype.BDS08,
+		adsbtype.BDS09, adsbtype.BDS20, adsbtype.BDS40, adsbtype.BDS50,
+		adsbtype.BDS51, adsbtype.BDS52, adsbtype.BDS60,
+	}
+
+	if !slices.Equal(regs, exp) {
+		t.Errorf("received %v, expected %v", regs, exp)
+	}
+
+	regs, err = adsb.DecodeBDS17(testMB(t, "a000083e202cc371c31de0aa1ccf"))
+	if err == nil || err.Error() != "error decoding register 1,7: invalid data" {
+		t.Error("received unexpected error", err)
+	}
+
+	if regs != nil {
+		t.Error("received unexpected data")
+	}
+}
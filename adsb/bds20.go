@@ -0,0 +1,97 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import "strings"
+
+// Registration is the aircraft registration and airline designator
+// from Comm-B register 2,1. Values which are not present in the
+// register are indicated by the corresponding Valid field being false.
+type Registration struct {
+	Registration string // aircraft registration marking
+	RegValid     bool   // aircraft registration is available
+	Airline      string // ICAO airline designator, first two characters
+	AirlineValid bool   // airline designator is available
+}
+
+// DecodeBDS20 decodes the aircraft identification from the MB field of
+// a reply containing Comm-B register 2,0. The identification must
+// contain only characters of the ICAO 6 bit alphabet, be left justified
+// and contain no embedded spaces.
+func DecodeBDS20(mb uint64) (string, error) {
+	if checkBDS20(mb) == 0 {
+		return "", newError(nil, "error decoding register 2,0: invalid data")
+	}
+
+	call, _ := decodeChars(mb, 9, 8)
+
+	return call, nil
+}
+
+// DecodeBDS21 decodes the aircraft registration and airline designator
+// from the MB field of a reply containing Comm-B register 2,1.
+func DecodeBDS21(mb uint64) (*Registration, error) {
+	b := &bdsScore{mb: mb}
+	regSet := b.status(1, 2, 43)
+	airSet := b.status(44, 45, 56)
+
+	reg, regOK := decodeChars(mb, 2, 7)
+	air, airOK := decodeChars(mb, 45, 2)
+
+	b.check(!regSet || regOK && reg != "")
+	b.check(!airSet || airOK && len(air) == 2)
+
+	if b.score() == 0 {
+		return nil, newError(nil, "error decoding register 2,1: invalid data")
+	}
+
+	r := new(Registration)
+
+	if regSet {
+		r.Registration = reg
+		r.RegValid = true
+	}
+
+	if airSet {
+		r.Airline = air
+		r.AirlineValid = true
+	}
+
+	return r, nil
+}
+
+// decodeChars decodes count characters of the ICAO 6 bit alphabet
+// beginning at bit n of a 56 bit message field. Trailing spaces are
+// removed. The returned flag is false if any character is invalid or
+// if a space is followed by another character.
+func decodeChars(f uint64, n int, count int) (string, bool) {
+	chars := make([]byte, count)
+
+	for i := range chars {
+		chars[i] = callChars[fieldBits(f, n+i*6, n+i*6+5)]
+	}
+
+	s := strings.TrimRight(string(chars), " ")
+
+	return s, !strings.ContainsAny(s, "? ")
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb_test
+
+import (
+	"errors"
+	"testing"
+
+	"kreklow.us/go/go-adsb/adsb"
+)
+
+// TestBDS20 runs the test cases for register 2,0 decoding.
+func TestBDS20(t *testing.T) {
+	t.Run("Valid", testBDS20Valid)
+	t.Run("Invalid", testBDS20Invalid)
+	t.Run("Call", testBDS20Call)
+}
+
+// test valid aircraft identifications.
+func testBDS20Valid(t *testing.T) {
+	for msg, exp := range map[string]string{
+		"a000083e202cc371c31de0aa1ccf": "KLM1017",
+		"a0000f9820057273df8d20e2cf30": "AWI3784",
+	} {
+		call, err := adsb.DecodeBDS20(testMB(t, msg))
+		if err != nil {
+			t.Fatal("received unexpected error", err)
+		}
+
+		if call != exp {
+			t.Errorf("received %s, expected %s", call, exp)
+		}
+	}
+}
+
+// test an embedded space, an invalid character and a different register.
+func testBDS20Invalid(t *testing.T) {
+	for _, msg := range []string{
+		"a000191020042803120820f488eb",
+		"a0001910200020c41461c8ff81c9",
+		"a00004128f39f91a7e27c46adc21",
+	} {
+		call, err := adsb.DecodeBDS20(testMB(t, msg))
+		if err == nil {
+			t.Fatal("received nil, expected error")
+		}
+
+		if err.Error() != "error decoding register 2,0: invalid data" {
+			t.Error("received unexpected error", err)
+		}
+
+		if call != "" {
+			t.Errorf("expected empty string, received %s", call)
+		}
+	}
+}
+
+// test that Call rejects an invalid identification.
+func testBDS20Call(t *testing.T) {
+	m := testMsg(t, "a000191020042803120820f488eb")
+
+	call, err := m.Call()
+	if !errors.Is(err, adsb.ErrNotAvailable) {
+		t.Error("expected ErrNotAvailable, received", err)
+	}
+
+	if call != "" {
+		t.Errorf("expected empty string, received %s", call)
+	}
+}
+
+// TestBDS21 runs the test cases for register 2,1 decoding.
+func TestBDS21(t *testing.T) {
+	for msg, exp := range map[string]adsb.Registration{
+		"a00019109d8e59e9ac110c56e173": {
+			Registration: "N12345",
+			RegValid:     true,
+			Airline:      "DL",
+			AirlineValid: true,
+		},
+		"a00019108e084189040000226566": {
+			Registration: "GABCD",
+			RegValid:     true,
+		},
+	} {
+		r, err := adsb.DecodeBDS21(testMB(t, msg))
+		if err != nil {
+			t.Fatal("received unexpected error", err)
+		}
+
+		if *r != exp {
+			t.Errorf("received %+v, expected %+v", *r, exp)
+		}
+	}
+
+	r, err := adsb.DecodeBDS21(testMB(t, "a00019100e0841890400001d0877"))
+	if err == nil || err.Error() != "error decoding register 2,1: invalid data" {
+		t.Error("received unexpected error", err)
+	}
+
+	if r != nil {
+		t.Error("received unexpected data")
+	}
+}
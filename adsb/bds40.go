@@ -0,0 +1,87 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"github.com/ccoveille/go-safecast/v2"
+	"kreklow.us/go/go-adsb/adsbtype"
+)
+
+// SelectedIntention is the selected vertical intention from Comm-B
+// register 4,0. Values which are not present in the register are
+// indicated by the corresponding Valid field being false.
+type SelectedIntention struct {
+	MCPAlt      int64   // MCP / FCU selected altitude in feet
+	MCPAltValid bool    // MCP / FCU selected altitude is available
+	FMSAlt      int64   // FMS selected altitude in feet
+	FMSAltValid bool    // FMS selected altitude is available
+	Baro        float64 // barometric pressure setting in millibars
+	BaroValid   bool    // barometric pressure setting is available
+
+	ModeValid bool // MCP / FCU mode bits are available
+	VNAV      bool // vertical navigation mode engaged
+	AltHold   bool // altitude hold mode engaged
+	Approach  bool // approach mode engaged
+
+	Source      adsbtype.AltSrc // target altitude source
+	SourceValid bool            // target altitude source is available
+}
+
+// DecodeBDS40 decodes the selected vertical intention from the MB field
+// of a reply containing Comm-B register 4,0.
+func DecodeBDS40(mb uint64) (*SelectedIntention, error) {
+	if checkBDS40(mb) == 0 {
+		return nil, newError(nil, "error decoding register 4,0: invalid data")
+	}
+
+	s := new(SelectedIntention)
+
+	if fieldBits(mb, 1, 1) == 1 {
+		s.MCPAlt = safecast.MustConvert[int64](fieldBits(mb, 2, 13) * 16)
+		s.MCPAltValid = true
+	}
+
+	if fieldBits(mb, 14, 14) == 1 {
+		s.FMSAlt = safecast.MustConvert[int64](fieldBits(mb, 15, 26) * 16)
+		s.FMSAltValid = true
+	}
+
+	if fieldBits(mb, 27, 27) == 1 {
+		s.Baro = float64(fieldBits(mb, 28, 39))*0.1 + 800
+		s.BaroValid = true
+	}
+
+	if fieldBits(mb, 48, 48) == 1 {
+		s.ModeValid = true
+		s.VNAV = fieldBits(mb, 49, 49) == 1
+		s.AltHold = fieldBits(mb, 50, 50) == 1
+		s.Approach = fieldBits(mb, 51, 51) == 1
+	}
+
+	if fieldBits(mb, 54, 54) == 1 {
+		s.Source = adsbtype.AltSrc(fieldBits(mb, 55, 56))
+		s.SourceValid = true
+	}
+
+	return s, nil
+}
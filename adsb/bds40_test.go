@@ -0,0 +1,100 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb_test
+
+import (
+	"math"
+	"testing"
+
+	"kreklow.us/go/go-adsb/adsb"
+	"kreklow.us/go/go-adsb/adsbtype"
+)
+
+// TestBDS40 runs the test cases for register 4,0 decoding.
+func TestBDS40(t *testing.T) {
+	t.Run("Altitudes", testBDS40Altitudes)
+	t.Run("Modes", testBDS40Modes)
+	t.Run("Invalid", testBDS40Invalid)
+}
+
+// test MCP and FMS selected altitudes.
+func testBDS40Altitudes(t *testing.T) {
+	testBDS40(t, "a000029c85e42f313000007047d3", &adsb.SelectedIntention{
+		MCPAlt:      3008,
+		MCPAltValid: true,
+		FMSAlt:      3008,
+		FMSAltValid: true,
+		Baro:        1020,
+		BaroValid:   true,
+	})
+}
+
+// test mode bits and target altitude source.
+func testBDS40Modes(t *testing.T) {
+	testBDS40(t, "a0001910c4600030a80146ac0594", &adsb.SelectedIntention{
+		MCPAlt:      35008,
+		MCPAltValid: true,
+		Baro:        1013.2,
+		BaroValid:   true,
+		ModeValid:   true,
+		AltHold:     true,
+		Source:      adsbtype.AltSrc2,
+		SourceValid: true,
+	})
+}
+
+func testBDS40(t *testing.T, msg string, exp *adsb.SelectedIntention) {
+	t.Helper()
+
+	s, err := adsb.DecodeBDS40(testMB(t, msg))
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	s.Baro = math.Round(s.Baro*10) / 10
+
+	if *s != *exp {
+		t.Errorf("received %+v, expected %+v", *s, *exp)
+	}
+}
+
+// test registers which are not valid 4,0 data.
+func testBDS40Invalid(t *testing.T) {
+	for _, msg := range []string{
+		"a0001910c4600030a80946dc6994",
+		"a000083e202cc371c31de0aa1ccf",
+	} {
+		s, err := adsb.DecodeBDS40(testMB(t, msg))
+		if err == nil {
+			t.Fatal("received nil, expected error")
+		}
+
+		if err.Error() != "error decoding register 4,0: invalid data" {
+			t.Error("received unexpected error", err)
+		}
+
+		if s != nil {
+			t.Error("received unexpected data")
+		}
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"github.com/ccoveille/go-safecast/v2"
+	"kreklow.us/go/go-adsb/adsbtype"
+)
+
+// MetRoutine is the meteorological routine air report from Comm-B
+// register 4,4. Values which are not present in the register are
+// indicated by the corresponding Valid field being false.
+type MetRoutine struct {
+	Source adsbtype.FOM // figure of merit / source
+
+	WindSpeed int64   // wind speed in knots
+	WindDir   float64 // true wind direction in degrees
+	WindValid bool    // wind speed and direction are available
+
+	Temp float64 // static air temperature in degrees Celsius
+
+	Pressure      int64 // average static pressure in hectopascals
+	PressureValid bool  // average static pressure is available
+
+	Turbulence adsbtype.HZD // turbulence level
+	TurbValid  bool         // turbulence level is available
+
+	Humidity      float64 // humidity in percent
+	HumidityValid bool    // humidity is available
+}
+
+// MetHazard is the meteorological hazard report from Comm-B register
+// 4,5. Values which are not present in the register are indicated by
+// the corresponding Valid field being false.
+type MetHazard struct {
+	Turbulence adsbtype.HZD // turbulence level
+	TurbValid  bool         // turbulence level is available
+
+	WindShear      adsbtype.HZD // wind shear level
+	WindShearValid bool         // wind shear level is available
+
+	Microburst      adsbtype.HZD // microburst level
+	MicroburstValid bool         // microburst level is available
+
+	Icing      adsbtype.HZD // icing level
+	IcingValid bool         // icing level is available
+
+	WakeVortex adsbtype.HZD // wake vortex level
+	WakeValid  bool         // wake vortex level is available
+
+	Temp      float64 // static air temperature in degrees Celsius
+	TempValid bool    // static air temperature is available
+
+	Pressure      int64 // average static pressure in hectopascals
+	PressureValid bool  // average static pressure is available
+
+	RadioHeight      int64 // radio height in feet
+	RadioHeightValid bool  // radio height is available
+}
+
+// DecodeBDS44 decodes the meteorological routine air report from the
+// MB field of a reply containing Comm-B register 4,4.
+func DecodeBDS44(mb uint64) (*MetRoutine, error) {
+	if checkBDS44(mb) == 0 {
+		return nil, newError(nil, "error decoding register 4,4: invalid data")
+	}
+
+	r := new(MetRoutine)
+	r.Source = adsbtype.FOM(fieldBits(mb, 1, 4))
+	r.Temp = float64(signedBits(mb, 24, 34)) * 0.25
+
+	if fieldBits(mb, 5, 5) == 1 {
+		r.WindSpeed = safecast.MustConvert[int64](fieldBits(mb, 6, 14))
+		r.WindDir = float64(fieldBits(mb, 15, 23)) * 180 / 256
+		r.WindValid = true
+	}
+
+	if fieldBits(mb, 35, 35) == 1 {
+		r.Pressure = safecast.MustConvert[int64](fieldBits(mb, 36, 46))
+		r.PressureValid = true
+	}
+
+	if fieldBits(mb, 47, 47) == 1 {
+		r.Turbulence = adsbtype.HZD(fieldBits(mb, 48, 49))
+		r.TurbValid = true
+	}
+
+	if fieldBits(mb, 50, 50) == 1 {
+		r.Humidity = float64(fieldBits(mb, 51, 56)) * 100 / 64
+		r.HumidityValid = true
+	}
+
+	return r, nil
+}
+
+// DecodeBDS45 decodes the meteorological hazard report from the MB
+// field of a reply containing Comm-B register 4,5.
+func DecodeBDS45(mb uint64) (*MetHazard, error) {
+	if checkBDS45(mb) == 0 {
+		return nil, newError(nil, "error decoding register 4,5: invalid data")
+	}
+
+	r := new(MetHazard)
+
+	hazard := func(n int, level *adsbtype.HZD, valid *bool) {
+		if fieldBits(mb, n, n) == 1 {
+			*level = adsbtype.HZD(fieldBits(mb, n+1, n+2))
+			*valid = true
+		}
+	}
+
+	hazard(1, &r.Turbulence, &r.TurbValid)
+	hazard(4, &r.WindShear, &r.WindShearValid)
+	hazard(7, &r.Microburst, &r.MicroburstValid)
+	hazard(10, &r.Icing, &r.IcingValid)
+	hazard(13, &r.WakeVortex, &r.WakeValid)
+
+	if fieldBits(mb, 16, 16) == 1 {
+		r.Temp = float64(signedBits(mb, 17, 26)) * 0.25
+		r.TempValid = true
+	}
+
+	if fieldBits(mb, 27, 27) == 1 {
+		r.Pressure = safecast.MustConvert[int64](fieldBits(mb, 28, 38))
+		r.PressureValid = true
+	}
+
+	if fieldBits(mb, 39, 39) == 1 {
+		r.RadioHeight = safecast.MustConvert[int64](fieldBits(mb, 40, 51) * 16)
+		r.RadioHeightValid = true
+	}
+
+	return r, nil
+}
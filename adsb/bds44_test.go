@@ -0,0 +1,151 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb_test
+
+import (
+	"math"
+	"testing"
+
+	"kreklow.us/go/go-adsb/adsb"
+	"kreklow.us/go/go-adsb/adsbtype"
+)
+
+// TestBDS44 runs the test cases for register 4,4 decoding.
+func TestBDS44(t *testing.T) {
+	t.Run("Wind", testBDS44Wind)
+	t.Run("Complete", testBDS44Complete)
+	t.Run("Invalid", testBDS44Invalid)
+}
+
+// test a report with wind and temperature only.
+func testBDS44Wind(t *testing.T) {
+	testBDS44(t, "a0001692185bd5cf400000dfc696", &adsb.MetRoutine{
+		Source:    adsbtype.FOM1,
+		WindSpeed: 22,
+		WindDir:   344.53,
+		WindValid: true,
+		Temp:      -48.75,
+	})
+}
+
+// test a report with all values present.
+func testBDS44Complete(t *testing.T) {
+	testBDS44(t, "a000191028b501e9a4b36091a7bb", &adsb.MetRoutine{
+		Source:        adsbtype.FOM2,
+		WindSpeed:     45,
+		WindDir:       90,
+		WindValid:     true,
+		Temp:          -22.5,
+		Pressure:      300,
+		PressureValid: true,
+		Turbulence:    adsbtype.HZD2,
+		TurbValid:     true,
+		Humidity:      50,
+		HumidityValid: true,
+	})
+}
+
+func testBDS44(t *testing.T, msg string, exp *adsb.MetRoutine) {
+	t.Helper()
+
+	r, err := adsb.DecodeBDS44(testMB(t, msg))
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	r.WindDir = math.Round(r.WindDir*100) / 100
+
+	if *r != *exp {
+		t.Errorf("received %+v, expected %+v", *r, *exp)
+	}
+}
+
+// test a register which is not valid 4,4 data.
+func testBDS44Invalid(t *testing.T) {
+	r, err := adsb.DecodeBDS44(testMB(t, "a000083e202cc371c31de0aa1ccf"))
+	if err == nil {
+		t.Fatal("received nil, expected error")
+	}
+
+	if err.Error() != "error decoding register 4,4: invalid data" {
+		t.Error("received unexpected error", err)
+	}
+
+	if r != nil {
+		t.Error("received unexpected data")
+	}
+}
+
+// TestBDS45 runs the test cases for register 4,5 decoding.
+func TestBDS45(t *testing.T) {
+	t.Run("Hazards", testBDS45Hazards)
+	t.Run("Invalid", testBDS45Invalid)
+}
+
+// test a report with hazards, temperature, pressure and radio height.
+func testBDS45Hazards(t *testing.T) {
+	r, err := adsb.DecodeBDS45(testMB(t, "a0001910a06ff5a3ea12c06f611a"))
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	exp := adsb.MetHazard{
+		Turbulence:       adsbtype.HZD1,
+		TurbValid:        true,
+		Icing:            adsbtype.HZD2,
+		IcingValid:       true,
+		WakeVortex:       adsbtype.HZD3,
+		WakeValid:        true,
+		Temp:             -10.5,
+		TempValid:        true,
+		Pressure:         250,
+		PressureValid:    true,
+		RadioHeight:      2400,
+		RadioHeightValid: true,
+	}
+
+	if *r != exp {
+		t.Errorf("received %+v, expected %+v", *r, exp)
+	}
+}
+
+// test registers which are not valid 4,5 data.
+func testBDS45Invalid(t *testing.T) {
+	for _, msg := range []string{
+		"a0001910a001f580000010406b35",
+		"a000083e202cc371c31de0aa1ccf",
+	} {
+		r, err := adsb.DecodeBDS45(testMB(t, msg))
+		if err == nil {
+			t.Fatal("received nil, expected error")
+		}
+
+		if err.Error() != "error decoding register 4,5: invalid data" {
+			t.Error("received unexpected error", err)
+		}
+
+		if r != nil {
+			t.Error("received unexpected data")
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import "github.com/ccoveille/go-safecast/v2"
+
+// TrackTurn is the track and turn report from Comm-B register 5,0.
+// Values which are not present in the register are indicated by the
+// corresponding Valid field being false.
+type TrackTurn struct {
+	Roll      float64 // roll angle in degrees, positive right wing down
+	RollValid bool    // roll angle is available
+
+	Track      float64 // true track angle in degrees
+	TrackValid bool    // true track angle is available
+
+	GroundSpeed int64 // ground speed in knots
+	GSValid     bool  // ground speed is available
+
+	TrackRate      float64 // track angle rate in degrees per second, positive right
+	TrackRateValid bool    // track angle rate is available
+
+	TAS      int64 // true airspeed in knots
+	TASValid bool  // true airspeed is available
+}
+
+// DecodeBDS50 decodes the track and turn report from the MB field of a
+// reply containing Comm-B register 5,0.
+func DecodeBDS50(mb uint64) (*TrackTurn, error) {
+	if checkBDS50(mb) == 0 {
+		return nil, newError(nil, "error decoding register 5,0: invalid data")
+	}
+
+	r := new(TrackTurn)
+
+	if fieldBits(mb, 1, 1) == 1 {
+		r.Roll = float64(signedBits(mb, 2, 11)) * 45 / 256
+		r.RollValid = true
+	}
+
+	if fieldBits(mb, 12, 12) == 1 {
+		r.Track = mod(float64(signedBits(mb, 13, 23))*90/512, 360)
+		r.TrackValid = true
+	}
+
+	if fieldBits(mb, 24, 24) == 1 {
+		r.GroundSpeed = safecast.MustConvert[int64](fieldBits(mb, 25, 34) * 2)
+		r.GSValid = true
+	}
+
+	if fieldBits(mb, 35, 35) == 1 {
+		r.TrackRate = float64(signedBits(mb, 36, 45)) * 8 / 256
+		r.TrackRateValid = true
+	}
+
+	if fieldBits(mb, 46, 46) == 1 {
+		r.TAS = safecast.MustConvert[int64](fieldBits(mb, 47, 56) * 2)
+		r.TASValid = true
+	}
+
+	return r, nil
+}
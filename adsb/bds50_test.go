@@ -0,0 +1,100 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb_test
+
+import (
+	"math"
+	"testing"
+
+	"kreklow.us/go/go-adsb/adsb"
+)
+
+// TestBDS50 runs the test cases for register 5,0 decoding.
+func TestBDS50(t *testing.T) {
+	t.Run("RightTurn", testBDS50Right)
+	t.Run("LeftTurn", testBDS50Left)
+	t.Run("Invalid", testBDS50Invalid)
+}
+
+// test a shallow right turn with all values present.
+func testBDS50Right(t *testing.T) {
+	testBDS50(t, "a000139381951536e024d4ccf6b5", &adsb.TrackTurn{
+		Roll:           2.11,
+		RollValid:      true,
+		Track:          114.26,
+		TrackValid:     true,
+		GroundSpeed:    438,
+		GSValid:        true,
+		TrackRate:      0.13,
+		TrackRateValid: true,
+		TAS:            424,
+		TASValid:       true,
+	})
+}
+
+// test a left turn with negative values and no true airspeed.
+func testBDS50Left(t *testing.T) {
+	testBDS50(t, "a0001910f1dc0125be80006e656d", &adsb.TrackTurn{
+		Roll:           -20.04,
+		RollValid:      true,
+		Track:          270,
+		TrackValid:     true,
+		GroundSpeed:    300,
+		GSValid:        true,
+		TrackRate:      -1.5,
+		TrackRateValid: true,
+	})
+}
+
+func testBDS50(t *testing.T, msg string, exp *adsb.TrackTurn) {
+	t.Helper()
+
+	r, err := adsb.DecodeBDS50(testMB(t, msg))
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	r.Roll = math.Round(r.Roll*100) / 100
+	r.Track = math.Round(r.Track*100) / 100
+	r.TrackRate = math.Round(r.TrackRate*100) / 100
+
+	if *r != *exp {
+		t.Errorf("received %+v, expected %+v", *r, *exp)
+	}
+}
+
+// test a register which is not valid 5,0 data.
+func testBDS50Invalid(t *testing.T) {
+	r, err := adsb.DecodeBDS50(testMB(t, "a000083e202cc371c31de0aa1ccf"))
+	if err == nil {
+		t.Fatal("received nil, expected error")
+	}
+
+	if err.Error() != "error decoding register 5,0: invalid data" {
+		t.Error("received unexpected error", err)
+	}
+
+	if r != nil {
+		t.Error("received unexpected data")
+	}
+}
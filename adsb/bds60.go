@@ -0,0 +1,82 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import "github.com/ccoveille/go-safecast/v2"
+
+// HeadingSpeed is the heading and speed report from Comm-B register
+// 6,0. Values which are not present in the register are indicated by
+// the corresponding Valid field being false.
+type HeadingSpeed struct {
+	Heading  float64 // magnetic heading in degrees
+	HdgValid bool    // magnetic heading is available
+
+	IAS      int64 // indicated airspeed in knots
+	IASValid bool  // indicated airspeed is available
+
+	Mach      float64 // Mach number
+	MachValid bool    // Mach number is available
+
+	BaroRate      int64 // barometric altitude rate in feet per minute
+	BaroRateValid bool  // barometric altitude rate is available
+
+	InertialRate      int64 // inertial vertical velocity in feet per minute
+	InertialRateValid bool  // inertial vertical velocity is available
+}
+
+// DecodeBDS60 decodes the heading and speed report from the MB field of
+// a reply containing Comm-B register 6,0.
+func DecodeBDS60(mb uint64) (*HeadingSpeed, error) {
+	if checkBDS60(mb) == 0 {
+		return nil, newError(nil, "error decoding register 6,0: invalid data")
+	}
+
+	r := new(HeadingSpeed)
+
+	if fieldBits(mb, 1, 1) == 1 {
+		r.Heading = mod(float64(signedBits(mb, 2, 12))*90/512, 360)
+		r.HdgValid = true
+	}
+
+	if fieldBits(mb, 13, 13) == 1 {
+		r.IAS = safecast.MustConvert[int64](fieldBits(mb, 14, 23))
+		r.IASValid = true
+	}
+
+	if fieldBits(mb, 24, 24) == 1 {
+		r.Mach = float64(fieldBits(mb, 25, 34)) * 2.048 / 512
+		r.MachValid = true
+	}
+
+	if fieldBits(mb, 35, 35) == 1 {
+		r.BaroRate = signedBits(mb, 36, 45) * 32
+		r.BaroRateValid = true
+	}
+
+	if fieldBits(mb, 46, 46) == 1 {
+		r.InertialRate = signedBits(mb, 47, 56) * 32
+		r.InertialRateValid = true
+	}
+
+	return r, nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb_test
+
+import (
+	"math"
+	"testing"
+
+	"kreklow.us/go/go-adsb/adsb"
+)
+
+// TestBDS60 runs the test cases for register 6,0 decoding.
+func TestBDS60(t *testing.T) {
+	t.Run("Descent", testBDS60Descent)
+	t.Run("Climb", testBDS60Climb)
+	t.Run("Invalid", testBDS60Invalid)
+}
+
+// test a descent with all values present.
+func testBDS60Descent(t *testing.T) {
+	testBDS60(t, "a00004128f39f91a7e27c46adc21", &adsb.HeadingSpeed{
+		Heading:           42.71,
+		HdgValid:          true,
+		IAS:               252,
+		IASValid:          true,
+		Mach:              0.42,
+		MachValid:         true,
+		BaroRate:          -1920,
+		BaroRateValid:     true,
+		InertialRate:      -1920,
+		InertialRateValid: true,
+	})
+}
+
+// test a climb with negative heading encoding and missing values.
+func testBDS60Climb(t *testing.T) {
+	testBDS60(t, "a0001910f009180020f0001fc748", &adsb.HeadingSpeed{
+		Heading:       315,
+		HdgValid:      true,
+		IAS:           140,
+		IASValid:      true,
+		BaroRate:      960,
+		BaroRateValid: true,
+	})
+}
+
+func testBDS60(t *testing.T, msg string, exp *adsb.HeadingSpeed) {
+	t.Helper()
+
+	r, err := adsb.DecodeBDS60(testMB(t, msg))
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	r.Heading = math.Round(r.Heading*100) / 100
+	r.Mach = math.Round(r.Mach*1000) / 1000
+
+	if *r != *exp {
+		t.Errorf("received %+v, expected %+v", *r, *exp)
+	}
+}
+
+// test registers which are not valid 6,0 data.
+func testBDS60Invalid(t *testing.T) {
+	for _, msg := range []string{
+		"a0001910f009194b000000219443",
+		"a000083e202cc371c31de0aa1ccf",
+	} {
+		r, err := adsb.DecodeBDS60(testMB(t, msg))
+		if err == nil {
+			t.Fatal("received nil, expected error")
+		}
+
+		if err.Error() != "error decoding register 6,0: invalid data" {
+			t.Error("received unexpected error", err)
+		}
+
+		if r != nil {
+			t.Error("received unexpected data")
+		}
+	}
+}
@@ -0,0 +1,421 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"math"
+	"slices"
+
+	"github.com/ccoveille/go-safecast/v2"
+	"kreklow.us/go/go-adsb/adsbtype"
+)
+
+// BDSContext provides the recent state of an aircraft, such as from
+// extended squitter velocity reports, which is used to distinguish
+// between Comm-B registers with similar layouts.
+//
+// Capability may be set to the registers reported by DecodeBDS17, in
+// which case registers the aircraft does not support are unlikely to be
+// inferred.
+type BDSContext struct {
+	GroundSpeed float64 // ground speed in knots
+	GSValid     bool    // ground speed is available
+	Track       float64 // ground track in degrees
+	TrkValid    bool    // ground track is available
+	Heading     float64 // heading in degrees
+	HdgValid    bool    // heading is available
+
+	Capability []adsbtype.BDS // supported registers, nil if unknown
+}
+
+// BDSMatch is a candidate Comm-B register. Confidence is relative to
+// the other candidates for the same MB field, so a single weak candidate
+// has a confidence of 1. Score is the plausibility of the register
+// contents alone, which can be used to reject weak candidates.
+type BDSMatch struct {
+	BDS        adsbtype.BDS // candidate register
+	Confidence float64      // share of the total score of all candidates, 0 to 1
+	Score      float64      // plausibility of the register contents, 0 to 1
+}
+
+// bdsChecks contains the plausibility check for each register which
+// can be inferred.
+var bdsChecks = []struct {
+	bds   adsbtype.BDS
+	check func(mb uint64) float64
+}{
+	{adsbtype.BDS10, checkBDS10},
+	{adsbtype.BDS17, checkBDS17},
+	{adsbtype.BDS20, checkBDS20},
+	{adsbtype.BDS30, checkBDS30},
+	{adsbtype.BDS40, checkBDS40},
+	{adsbtype.BDS44, checkBDS44},
+	{adsbtype.BDS45, checkBDS45},
+	{adsbtype.BDS50, checkBDS50},
+	{adsbtype.BDS60, checkBDS60},
+}
+
+// InferBDS returns the Comm-B registers which the MB field could
+// plausibly contain, ordered from most to least likely. The context is
+// optional and is used to choose between registers 5,0 and 6,0 and to
+// exclude registers the aircraft does not support.
+//
+// Registers are scored on their status bits, reserved bits and the
+// range of the values they contain. An empty slice is returned if no
+// register is plausible.
+func InferBDS(mb uint64, ctx *BDSContext) []BDSMatch {
+	matches := make([]BDSMatch, 0, len(bdsChecks))
+
+	var total float64
+
+	for _, c := range bdsChecks {
+		plaus := c.check(mb)
+		if plaus == 0 {
+			continue
+		}
+
+		score := plaus * contextFactor(c.bds, mb, ctx)
+		total += score
+
+		matches = append(matches, BDSMatch{BDS: c.bds, Confidence: score, Score: plaus})
+	}
+
+	for i := range matches {
+		matches[i].Confidence /= total
+	}
+
+	slices.SortStableFunc(matches, func(a, b BDSMatch) int {
+		switch {
+		case a.Confidence > b.Confidence:
+			return -1
+		case a.Confidence < b.Confidence:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return matches
+}
+
+// InferBDS returns the most likely Comm-B register contained in a DF20
+// or DF21 reply. See the InferBDS function for details.
+func (m *Message) InferBDS(ctx *BDSContext) (*BDSMatch, error) {
+	mb, err := m.raw.MB()
+	if err != nil {
+		return nil, newError(err, "error inferring register")
+	}
+
+	matches := InferBDS(mb, ctx)
+	if len(matches) == 0 {
+		return nil, newError(nil, "error inferring register: no plausible register")
+	}
+
+	return &matches[0], nil
+}
+
+// bdsScore accumulates the plausibility of a candidate register.
+type bdsScore struct {
+	mb     uint64
+	fields int
+	set    int
+	bad    bool
+}
+
+// status checks that value bits n through z are zero when status bit s
+// is clear, and reports whether the value is present.
+func (b *bdsScore) status(s, n, z int) bool {
+	b.fields++
+
+	if fieldBits(b.mb, s, s) == 0 {
+		if fieldBits(b.mb, n, z) != 0 {
+			b.bad = true
+		}
+
+		return false
+	}
+
+	b.set++
+
+	return true
+}
+
+// reserved checks that bits n through z are zero.
+func (b *bdsScore) reserved(n, z int) {
+	if fieldBits(b.mb, n, z) != 0 {
+		b.bad = true
+	}
+}
+
+// check marks the register implausible if ok is false.
+func (b *bdsScore) check(ok bool) {
+	if !ok {
+		b.bad = true
+	}
+}
+
+// score returns the plausibility of the register, with registers
+// containing more values scoring higher. A register with no values is
+// not plausible.
+func (b *bdsScore) score() float64 {
+	if b.bad || b.set == 0 {
+		return 0
+	}
+
+	return 0.5 + 0.5*float64(b.set)/float64(b.fields)
+}
+
+// signedBits returns bits n through z of a 56 bit message field as a
+// two's complement value.
+func signedBits(f uint64, n int, z int) int64 {
+	v := safecast.MustConvert[int64](fieldBits(f, n, z))
+	if v&(1<<(z-n)) != 0 {
+		v -= 1 << (z - n + 1)
+	}
+
+	return v
+}
+
+// checkBDS10 checks the data link capability report.
+func checkBDS10(mb uint64) float64 {
+	if fieldBits(mb, 1, 8) != 0x10 || fieldBits(mb, 10, 14) != 0 {
+		return 0
+	}
+
+	return 1
+}
+
+// checkBDS17 checks the common usage GICB capability report, which must
+// at least report the aircraft identification capability.
+func checkBDS17(mb uint64) float64 {
+	if fieldBits(mb, 7, 7) == 0 || fieldBits(mb, 25, 56) != 0 {
+		return 0
+	}
+
+	return 0.8
+}
+
+// checkBDS20 checks the aircraft identification.
+func checkBDS20(mb uint64) float64 {
+	if fieldBits(mb, 1, 8) != 0x20 {
+		return 0
+	}
+
+	if call, ok := decodeChars(mb, 9, 8); !ok || call == "" {
+		return 0
+	}
+
+	return 1
+}
+
+// checkBDS30 checks the ACAS active resolution advisory.
+func checkBDS30(mb uint64) float64 {
+	if fieldBits(mb, 1, 8) != 0x30 || fieldBits(mb, 29, 30) == 3 {
+		return 0
+	}
+
+	return 1
+}
+
+// checkBDS40 checks the selected vertical intention.
+func checkBDS40(mb uint64) float64 {
+	b := &bdsScore{mb: mb}
+
+	if b.status(1, 2, 13) {
+		b.check(fieldBits(mb, 2, 13)*16 <= 50000)
+	}
+
+	if b.status(14, 15, 26) {
+		b.check(fieldBits(mb, 15, 26)*16 <= 50000)
+	}
+
+	b.status(27, 28, 39)
+	b.reserved(40, 47)
+	b.status(48, 49, 51)
+	b.reserved(52, 53)
+	b.status(54, 55, 56)
+
+	return b.score()
+}
+
+// checkBDS44 checks the meteorological routine air report.
+func checkBDS44(mb uint64) float64 {
+	b := &bdsScore{mb: mb}
+
+	b.check(fieldBits(mb, 1, 4) <= 4)
+
+	if b.status(5, 6, 23) {
+		b.check(fieldBits(mb, 6, 14) <= 250)
+	}
+
+	t := float64(signedBits(mb, 24, 34)) * 0.25
+	b.check(t >= -80 && t <= 60)
+
+	b.status(35, 36, 46)
+	b.status(47, 48, 49)
+	b.status(50, 51, 56)
+
+	return b.score()
+}
+
+// checkBDS45 checks the meteorological hazard report.
+func checkBDS45(mb uint64) float64 {
+	b := &bdsScore{mb: mb}
+
+	b.status(1, 2, 3)
+	b.status(4, 5, 6)
+	b.status(7, 8, 9)
+	b.status(10, 11, 12)
+	b.status(13, 14, 15)
+
+	if b.status(16, 17, 26) {
+		t := float64(signedBits(mb, 17, 26)) * 0.25
+		b.check(t >= -80 && t <= 60)
+	}
+
+	b.status(27, 28, 38)
+	b.status(39, 40, 51)
+	b.reserved(52, 56)
+
+	// register 4,5 is rarely available, halve its likelihood
+	return b.score() / 2
+}
+
+// checkBDS50 checks the track and turn report.
+func checkBDS50(mb uint64) float64 {
+	b := &bdsScore{mb: mb}
+
+	if b.status(1, 2, 11) {
+		b.check(math.Abs(float64(signedBits(mb, 2, 11))*45/256) <= 50)
+	}
+
+	b.status(12, 13, 23)
+
+	gs := fieldBits(mb, 25, 34) * 2
+	if b.status(24, 25, 34) {
+		b.check(gs <= 600)
+	}
+
+	b.status(35, 36, 45)
+
+	tas := fieldBits(mb, 47, 56) * 2
+	if b.status(46, 47, 56) {
+		b.check(tas <= 500)
+
+		if gs != 0 {
+			b.check(math.Abs(float64(gs)-float64(tas)) <= 200)
+		}
+	}
+
+	return b.score()
+}
+
+// checkBDS60 checks the heading and speed report.
+func checkBDS60(mb uint64) float64 {
+	b := &bdsScore{mb: mb}
+
+	b.status(1, 2, 12)
+
+	if b.status(13, 14, 23) {
+		b.check(fieldBits(mb, 14, 23) <= 500)
+	}
+
+	if b.status(24, 25, 34) {
+		b.check(float64(fieldBits(mb, 25, 34))*2.048/512 <= 1)
+	}
+
+	baro := signedBits(mb, 36, 45) * 32
+	if b.status(35, 36, 45) {
+		b.check(baro >= -6000 && baro <= 6000)
+	}
+
+	if b.status(46, 47, 56) {
+		ins := signedBits(mb, 47, 56) * 32
+		b.check(ins >= -6000 && ins <= 6000)
+
+		if fieldBits(mb, 35, 35) == 1 {
+			b.check(math.Abs(float64(baro-ins)) <= 2000)
+		}
+	}
+
+	return b.score()
+}
+
+// contextFactor returns a multiplier for the score of a register based
+// on its agreement with the aircraft context.
+func contextFactor(bds adsbtype.BDS, mb uint64, ctx *BDSContext) float64 {
+	if ctx == nil {
+		return 1
+	}
+
+	f := 1.0
+
+	if ctx.Capability != nil && slices.Contains(gicbTbl, bds) &&
+		!slices.Contains(ctx.Capability, bds) {
+		f *= 0.1
+	}
+
+	agree := func(ok bool) {
+		if ok {
+			f *= 2
+		} else {
+			f *= 0.1
+		}
+	}
+
+	switch bds {
+	case adsbtype.BDS50:
+		if ctx.GSValid && fieldBits(mb, 24, 24) == 1 {
+			gs := float64(fieldBits(mb, 25, 34) * 2)
+			agree(math.Abs(gs-ctx.GroundSpeed) <= 50)
+		}
+
+		if ctx.TrkValid && fieldBits(mb, 12, 12) == 1 {
+			trk := float64(signedBits(mb, 13, 23)) * 90 / 512
+			agree(angleDiff(trk, ctx.Track) <= 20)
+		}
+	case adsbtype.BDS60:
+		if fieldBits(mb, 1, 1) == 0 {
+			break
+		}
+
+		hdg := float64(signedBits(mb, 2, 12)) * 90 / 512
+
+		switch {
+		case ctx.HdgValid:
+			agree(angleDiff(hdg, ctx.Heading) <= 20)
+		case ctx.TrkValid:
+			agree(angleDiff(hdg, ctx.Track) <= 45)
+		}
+	}
+
+	return f
+}
+
+// angleDiff returns the absolute difference between two angles in
+// degrees.
+func angleDiff(a, b float64) float64 {
+	d := mod(a-b, 360)
+
+	return math.Min(d, 360-d)
+}
@@ -0,0 +1,188 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb_test
+
+import (
+	"errors"
+	"testing"
+
+	"kreklow.us/go/go-adsb/adsb"
+	"kreklow.us/go/go-adsb/adsbtype"
+)
+
+// TestInferBDS runs the test cases for Comm-B register inference.
+func TestInferBDS(t *testing.T) {
+	t.Run("Registers", testInferBDSRegisters)
+	t.Run("Ambiguous", testInferBDSAmbiguous)
+	t.Run("Context", testInferBDSContext)
+	t.Run("Score", testInferBDSScore)
+	t.Run("NoMatch", testInferBDSNoMatch)
+	t.Run("NotAvailable", testInferBDSNotAvailable)
+}
+
+// test that a single weak candidate has full confidence but a low score.
+func testInferBDSScore(t *testing.T) {
+	matches := adsb.InferBDS(0x9048c800000000, nil)
+	if len(matches) != 1 {
+		t.Fatalf("received %d matches, expected 1", len(matches))
+	}
+
+	exp := adsb.BDSMatch{BDS: adsbtype.BDS60, Confidence: 1, Score: 0.7}
+	if matches[0] != exp {
+		t.Errorf("received %+v, expected %+v", matches[0], exp)
+	}
+
+	matches = adsb.InferBDS(testMB(t, "a0001690a00a3130e00400ddc88a"), nil)
+	if len(matches) != 1 || matches[0].Score != 1 {
+		t.Errorf("received %+v, expected a score of 1", matches)
+	}
+}
+
+// test registers which can be identified without context.
+func testInferBDSRegisters(t *testing.T) {
+	for msg, exp := range map[string]adsbtype.BDS{
+		"a800178d10010080f50000d5893c": adsbtype.BDS10,
+		"a0000638fa81c10000000081a92f": adsbtype.BDS17,
+		"a000083e202cc371c31de0aa1ccf": adsbtype.BDS20,
+		"a000029c85e42f313000007047d3": adsbtype.BDS40,
+		"a0001692185bd5cf400000dfc696": adsbtype.BDS44,
+		"a000139381951536e024d4ccf6b5": adsbtype.BDS50,
+		"a00004128f39f91a7e27c46adc21": adsbtype.BDS60,
+	} {
+		testInferBDS(t, msg, nil, exp)
+	}
+}
+
+// test a register which is plausible as both 5,0 and 6,0.
+func testInferBDSAmbiguous(t *testing.T) {
+	m := testMsg(t, "a000191085300110221c55cf0ec0")
+
+	mb, err := m.Raw().MB()
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	matches := adsb.InferBDS(mb, nil)
+	if len(matches) != 2 {
+		t.Fatalf("received %d matches, expected 2", len(matches))
+	}
+
+	if matches[0].BDS != adsbtype.BDS50 || matches[1].BDS != adsbtype.BDS60 {
+		t.Errorf("received %+v", matches)
+	}
+
+	if matches[0].Confidence+matches[1].Confidence != 1 {
+		t.Errorf("received %+v, expected total confidence 1", matches)
+	}
+}
+
+// test aircraft context resolving an ambiguous register.
+func testInferBDSContext(t *testing.T) {
+	msg := "a000191085300110221c55cf0ec0"
+
+	testInferBDS(t, msg, &adsb.BDSContext{
+		GroundSpeed: 130,
+		GSValid:     true,
+		Track:       359,
+		TrkValid:    true,
+	}, adsbtype.BDS50)
+
+	testInferBDS(t, msg, &adsb.BDSContext{
+		Heading:  15,
+		HdgValid: true,
+	}, adsbtype.BDS60)
+
+	testInferBDS(t, msg, &adsb.BDSContext{
+		GroundSpeed: 250,
+		GSValid:     true,
+	}, adsbtype.BDS60)
+
+	testInferBDS(t, msg, &adsb.BDSContext{
+		Capability: []adsbtype.BDS{adsbtype.BDS20, adsbtype.BDS40, adsbtype.BDS60},
+	}, adsbtype.BDS60)
+}
+
+func testInferBDS(t *testing.T, msg string, ctx *adsb.BDSContext, exp adsbtype.BDS) {
+	t.Helper()
+
+	m := testMsg(t, msg)
+
+	b, err := m.InferBDS(ctx)
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	if b.BDS != exp {
+		t.Errorf("%s: received %s, expected %s", msg, b.BDS, exp)
+	}
+
+	if b.Confidence <= 0.5 || b.Confidence > 1 {
+		t.Errorf("%s: received confidence %f", msg, b.Confidence)
+	}
+}
+
+// test a register which is not plausible.
+func testInferBDSNoMatch(t *testing.T) {
+	m := testMsg(t, "a0000691e8d9df7c8b0000a9e1c3")
+
+	b, err := m.InferBDS(nil)
+	if err == nil {
+		t.Fatal("received nil, expected error")
+	}
+
+	if err.Error() != "error inferring register: no plausible register" {
+		t.Error("received unexpected error", err)
+	}
+
+	if b != nil {
+		t.Error("received unexpected data")
+	}
+}
+
+// test a message without a Comm-B field.
+func testInferBDSNotAvailable(t *testing.T) {
+	m := testMsg(t, "20001910bc45e9")
+
+	b, err := m.InferBDS(nil)
+	if !errors.Is(err, adsb.ErrNotAvailable) {
+		t.Error("expected ErrNotAvailable, received", err)
+	}
+
+	if b != nil {
+		t.Error("received unexpected data")
+	}
+}
+
+// testMB returns the MB field of a DF20 or DF21 message.
+func testMB(t *testing.T, msg string) uint64 {
+	t.Helper()
+
+	m := testMsg(t, msg)
+
+	mb, err := m.Raw().MB()
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	return mb
+}
@@ -0,0 +1,210 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/ccoveille/go-safecast/v2"
+)
+
+// ELM is a downlink extended length message reassembled from the
+// segments of DF24 replies.
+type ELM struct {
+	ICAO     uint64 // aircraft address
+	Segments int    // number of segments
+	Data     []byte // message data, 10 bytes per segment
+}
+
+// ELMReassembler collects the segments of downlink extended length
+// messages and returns each message once all of its segments have been
+// received. Segments may be received in any order and duplicates are
+// ignored. An ELMReassembler is not safe for concurrent use.
+//
+// The number of segments in a message is taken from the DR field of the
+// DF4, DF5, DF20 or DF21 reply announcing it, which may be received
+// before or after the segments. Segments without an announcement are held until
+// one is received or the timeout expires.
+type ELMReassembler struct {
+	timeout   time.Duration
+	transfers map[uint64]*elmTransfer
+}
+
+// elmTransfer is a partially received extended length message.
+type elmTransfer struct {
+	count   int        // announced number of segments, 0 if unknown
+	segs    [16][]byte // segment data indexed by ND
+	updated time.Time  // time of the last segment or announcement
+}
+
+// NewELMReassembler returns an ELMReassembler which discards incomplete
+// messages that have not been updated within the timeout. A timeout of
+// zero never discards incomplete messages.
+func NewELMReassembler(timeout time.Duration) *ELMReassembler {
+	return &ELMReassembler{
+		timeout:   timeout,
+		transfers: make(map[uint64]*elmTransfer),
+	}
+}
+
+// Add processes a message received at time t. DF4, DF5, DF20 and DF21
+// replies announcing a downlink ELM set the expected number of segments
+// and DF24 downlink ELM segments are stored. If the message completes an
+// ELM, the ELM is returned and ok is true. Other messages are ignored.
+func (r *ELMReassembler) Add(m *Message, t time.Time) (*ELM, bool, error) {
+	if m.raw == nil {
+		return nil, false, newError(nil, "no data loaded")
+	}
+
+	df, err := m.raw.DF()
+	if err != nil {
+		return nil, false, newError(err, "error reassembling ELM")
+	}
+
+	switch df {
+	case 4, 5, 20, 21:
+		dr, _ := m.raw.DR()
+		if dr < 16 {
+			return nil, false, nil
+		}
+
+		icao, err := m.ICAO()
+		if err != nil {
+			return nil, false, newError(err, "error reassembling ELM")
+		}
+
+		n := safecast.MustConvert[int](dr) - 15
+
+		tr := r.transfer(icao, t)
+		if tr.count != 0 && tr.count != n {
+			*tr = elmTransfer{}
+		}
+
+		tr.count = n
+
+		tr.updated = t
+
+		return r.complete(icao, tr)
+	case 24:
+		return r.segment(m, t)
+	default:
+		return nil, false, nil
+	}
+}
+
+// Expire discards incomplete messages which have not been updated
+// within the timeout as of time t.
+func (r *ELMReassembler) Expire(t time.Time) {
+	for icao, tr := range r.transfers {
+		if r.expired(tr, t) {
+			delete(r.transfers, icao)
+		}
+	}
+}
+
+// segment stores a DF24 downlink ELM segment.
+func (r *ELMReassembler) segment(m *Message, t time.Time) (*ELM, bool, error) {
+	ke, err := m.raw.KE()
+	if err != nil {
+		return nil, false, newError(err, "error reassembling ELM")
+	}
+
+	if ke != 0 { // uplink ELM acknowledgement
+		return nil, false, nil
+	}
+
+	icao, err := m.ICAO()
+	if err != nil {
+		return nil, false, newError(err, "error reassembling ELM")
+	}
+
+	nd, _ := m.raw.ND()
+	md, _ := m.raw.MD()
+
+	tr := r.transfer(icao, t)
+
+	switch {
+	case tr.segs[nd] == nil:
+	case bytes.Equal(tr.segs[nd], md):
+		tr.updated = t
+
+		return nil, false, nil
+	default: // differing segment begins a new message
+		tr.segs = [16][]byte{}
+	}
+
+	if safecast.MustConvert[int](nd) >= tr.count {
+		tr.count = 0
+	}
+
+	tr.segs[nd] = md
+	tr.updated = t
+
+	return r.complete(icao, tr)
+}
+
+// transfer returns the message in progress for an address, replacing
+// it if it has expired.
+func (r *ELMReassembler) transfer(icao uint64, t time.Time) *elmTransfer {
+	tr, ok := r.transfers[icao]
+	if !ok || r.expired(tr, t) {
+		tr = new(elmTransfer)
+		r.transfers[icao] = tr
+	}
+
+	return tr
+}
+
+// expired reports whether a message has not been updated within the
+// timeout as of time t.
+func (r *ELMReassembler) expired(tr *elmTransfer, t time.Time) bool {
+	return r.timeout > 0 && t.Sub(tr.updated) > r.timeout
+}
+
+// complete returns the reassembled message if it has been announced and
+// all segments have been received.
+func (r *ELMReassembler) complete(icao uint64, tr *elmTransfer) (*ELM, bool, error) {
+	n := tr.count
+	if n == 0 {
+		return nil, false, nil
+	}
+
+	for i := range n {
+		if tr.segs[i] == nil {
+			return nil, false, nil
+		}
+	}
+
+	e := &ELM{ICAO: icao, Segments: n, Data: make([]byte, 0, n*10)}
+
+	// the initial segment has the highest ND and the final segment has
+	// an ND of zero
+	for i := n - 1; i >= 0; i-- {
+		e.Data = append(e.Data, tr.segs[i]...)
+	}
+
+	delete(r.transfers, icao)
+
+	return e, true, nil
+}
@@ -0,0 +1,237 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"kreklow.us/go/go-adsb/adsb"
+)
+
+// DF24 segments 2, 1 and 0 of a three segment message from 4840d6, and
+// DF20, DF4 and DF5 replies announcing it.
+const (
+	elmSeg2     = "c222232425262728292a2ba0daf8"
+	elmSeg1     = "c11112131415161718191a0cfaa0"
+	elmSeg0     = "c000010203040506070809a16f30"
+	elmSeg1Alt  = "c180818283848586878889ef3697"
+	elmAck      = "d000010203040506070809d9b48f"
+	elmOther    = "c000010203040506070809d54a60"
+	elmAnnounce = "a090191000000000000000285f06"
+	elmAnnDF4   = "20901910613f6c"
+	elmAnnDF5   = "28901910c121fd"
+	elmNoELM    = "a0001910200000000000008e3cef"
+)
+
+// elmData is the reassembled data of the three segment message.
+var elmData = []byte{
+	0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28, 0x29, 0x2a, 0x2b,
+	0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a,
+	0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09,
+}
+
+// TestELM runs the test cases for ELM reassembly.
+func TestELM(t *testing.T) {
+	t.Run("Announced", testELMAnnounced)
+	t.Run("Unannounced", testELMUnannounced)
+	t.Run("LateAnnouncement", testELMLateAnnouncement)
+	t.Run("Surveillance", testELMSurveillance)
+	t.Run("Replaced", testELMReplaced)
+	t.Run("Timeout", testELMTimeout)
+	t.Run("Expire", testELMExpire)
+	t.Run("Addresses", testELMAddresses)
+	t.Run("Ignored", testELMIgnored)
+	t.Run("NoData", testELMNoData)
+}
+
+// test segments received after the announcement, including a duplicate.
+func testELMAnnounced(t *testing.T) {
+	r := adsb.NewELMReassembler(time.Second)
+	ts := time.Unix(0, 0)
+
+	testELMAdd(t, r, elmAnnounce, ts, false)
+	testELMAdd(t, r, elmSeg2, ts, false)
+	testELMAdd(t, r, elmSeg0, ts, false)
+	testELMAdd(t, r, elmSeg0, ts, false)
+	testELMComplete(t, r, elmSeg1, ts)
+}
+
+// test segments held until a late announcement, beginning with the final segment.
+func testELMUnannounced(t *testing.T) {
+	r := adsb.NewELMReassembler(0)
+	ts := time.Unix(0, 0)
+
+	testELMAdd(t, r, elmSeg0, ts, false)
+	testELMAdd(t, r, elmSeg1, ts, false)
+	testELMAdd(t, r, elmSeg2, ts, false)
+	testELMComplete(t, r, elmAnnounce, ts)
+}
+
+// test an announcement received between segments.
+func testELMLateAnnouncement(t *testing.T) {
+	r := adsb.NewELMReassembler(time.Second)
+	ts := time.Unix(0, 0)
+
+	testELMAdd(t, r, elmSeg2, ts, false)
+	testELMAdd(t, r, elmSeg1, ts, false)
+	testELMAdd(t, r, elmAnnounce, ts, false)
+	testELMComplete(t, r, elmSeg0, ts)
+}
+
+// test announcements in DF4 and DF5 surveillance replies.
+func testELMSurveillance(t *testing.T) {
+	for _, ann := range []string{elmAnnDF4, elmAnnDF5} {
+		r := adsb.NewELMReassembler(time.Second)
+		ts := time.Unix(0, 0)
+
+		testELMAdd(t, r, ann, ts, false)
+		testELMAdd(t, r, elmSeg2, ts, false)
+		testELMAdd(t, r, elmSeg1, ts, false)
+		testELMComplete(t, r, elmSeg0, ts)
+	}
+}
+
+// test a differing segment beginning a new message.
+func testELMReplaced(t *testing.T) {
+	r := adsb.NewELMReassembler(time.Second)
+	ts := time.Unix(0, 0)
+
+	testELMAdd(t, r, elmAnnounce, ts, false)
+	testELMAdd(t, r, elmSeg1Alt, ts, false)
+	testELMAdd(t, r, elmSeg2, ts, false)
+	testELMAdd(t, r, elmSeg1, ts, false)
+	testELMAdd(t, r, elmSeg0, ts, false)
+	testELMComplete(t, r, elmSeg2, ts)
+}
+
+// test segments discarded after the timeout.
+func testELMTimeout(t *testing.T) {
+	r := adsb.NewELMReassembler(time.Second)
+	ts := time.Unix(0, 0)
+
+	testELMAdd(t, r, elmAnnounce, ts, false)
+	testELMAdd(t, r, elmSeg2, ts, false)
+	testELMAdd(t, r, elmSeg1, ts, false)
+
+	ts = ts.Add(2 * time.Second)
+
+	testELMAdd(t, r, elmAnnounce, ts, false)
+	testELMAdd(t, r, elmSeg0, ts, false)
+}
+
+// test segments discarded by Expire.
+func testELMExpire(t *testing.T) {
+	r := adsb.NewELMReassembler(time.Second)
+	ts := time.Unix(0, 0)
+
+	testELMAdd(t, r, elmAnnounce, ts, false)
+	testELMAdd(t, r, elmSeg2, ts, false)
+	testELMAdd(t, r, elmSeg1, ts, false)
+
+	r.Expire(ts.Add(2 * time.Second))
+
+	testELMAdd(t, r, elmAnnounce, ts, false)
+	testELMAdd(t, r, elmSeg0, ts, false)
+}
+
+// test segments from another address kept separate.
+func testELMAddresses(t *testing.T) {
+	r := adsb.NewELMReassembler(time.Second)
+	ts := time.Unix(0, 0)
+
+	testELMAdd(t, r, elmAnnounce, ts, false)
+	testELMAdd(t, r, elmSeg2, ts, false)
+	testELMAdd(t, r, elmSeg1, ts, false)
+	testELMAdd(t, r, elmOther, ts, false)
+	testELMComplete(t, r, elmSeg0, ts)
+}
+
+// test messages which are not part of a downlink ELM.
+func testELMIgnored(t *testing.T) {
+	r := adsb.NewELMReassembler(time.Second)
+	ts := time.Unix(0, 0)
+
+	testELMAdd(t, r, elmNoELM, ts, false)
+	testELMAdd(t, r, elmAck, ts, false)
+	testELMAdd(t, r, "8d4840d6202cc371c32ce0576098", ts, false)
+}
+
+// test a message without data.
+func testELMNoData(t *testing.T) {
+	r := adsb.NewELMReassembler(time.Second)
+
+	e, ok, err := r.Add(new(adsb.Message), time.Unix(0, 0))
+	if err == nil {
+		t.Error("expected error, received nil")
+	}
+
+	if e != nil || ok {
+		t.Error("received unexpected data")
+	}
+}
+
+// testELMAdd adds a message and tests whether it completes an ELM.
+func testELMAdd(t *testing.T, r *adsb.ELMReassembler, msg string, ts time.Time, exp bool) {
+	t.Helper()
+
+	m := testMsg(t, msg)
+
+	e, ok, err := r.Add(m, ts)
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	if ok != exp || (e != nil) != exp {
+		t.Errorf("received %t, expected %t", ok, exp)
+	}
+}
+
+// testELMComplete adds the final message and tests the reassembled ELM.
+func testELMComplete(t *testing.T, r *adsb.ELMReassembler, msg string, ts time.Time) {
+	t.Helper()
+
+	m := testMsg(t, msg)
+
+	e, ok, err := r.Add(m, ts)
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	if !ok || e == nil {
+		t.Fatal("expected complete ELM")
+	}
+
+	if e.ICAO != 0x4840d6 {
+		t.Errorf("received %06x, expected 4840d6", e.ICAO)
+	}
+
+	if e.Segments != 3 {
+		t.Errorf("received %d, expected 3", e.Segments)
+	}
+
+	if !bytes.Equal(e.Data, elmData) {
+		t.Errorf("received %x, expected %x", e.Data, elmData)
+	}
+}
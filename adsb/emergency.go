@@ -0,0 +1,56 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import "kreklow.us/go/go-adsb/adsbtype"
+
+// Emergency is an extended squitter emergency / priority status report.
+type Emergency struct {
+	State adsbtype.EPS // emergency / priority status
+	Sqk   Squawk       // Mode A code
+}
+
+// Emergency returns the emergency / priority status broadcast in an
+// extended squitter type code 28 subtype 1 message.
+func (m *Message) Emergency() (*Emergency, error) {
+	tc, err := m.raw.ESType()
+	if err != nil {
+		return nil, newError(err, "error retrieving emergency status")
+	}
+
+	if tc != 28 {
+		return nil, newError(ErrNotAvailable, "error retrieving emergency status")
+	}
+
+	st, _ := m.raw.ESSubtype()
+	if st != 1 {
+		return nil, newErrorf(ErrNotAvailable,
+			"error retrieving emergency status from subtype %d", st)
+	}
+
+	e := new(Emergency)
+	e.State = adsbtype.EPS(m.raw.esbits(9, 11))
+	e.Sqk = m.modeA(44)
+
+	return e, nil
+}
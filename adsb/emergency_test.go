@@ -0,0 +1,98 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"kreklow.us/go/go-adsb/adsb"
+	"kreklow.us/go/go-adsb/adsbtype"
+)
+
+// TestEmergency runs the test cases for emergency status decoding.
+func TestEmergency(t *testing.T) {
+	t.Run("Status", testEmergencyStatus)
+	t.Run("Subtype", testEmergencySubtype)
+	t.Run("NotAvailable", testEmergencyNotAvailable)
+}
+
+func testEmergencyStatus(t *testing.T) {
+	m := testMsg(t, "8d4ca123e1aaa200000000fc68dc")
+
+	e, err := m.Emergency()
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	if e.State != adsbtype.EPS5 {
+		t.Errorf("State: received %s, expected %s", e.State, adsbtype.EPS5)
+	}
+
+	if !bytes.Equal(e.Sqk, []byte{7, 5, 0, 0}) {
+		t.Errorf("Sqk: received %v, expected 7500", e.Sqk)
+	}
+}
+
+// test an RA broadcast and an undefined subtype.
+func testEmergencySubtype(t *testing.T) {
+	for msg, e := range map[string]string{
+		"8d4ca123e2c2000ae30690d89075": "error retrieving emergency status from subtype 2: field not available",
+		"8d4ca123e00000000000004b2441": "error retrieving emergency status from subtype 0: field not available",
+	} {
+		m := testMsg(t, msg)
+
+		s, err := m.Emergency()
+		if err == nil {
+			t.Fatal("received nil, expected error")
+		}
+
+		if err.Error() != e {
+			t.Error("received unexpected error", err)
+		}
+
+		if s != nil {
+			t.Error("received unexpected data")
+		}
+	}
+}
+
+func testEmergencyNotAvailable(t *testing.T) {
+	for _, msg := range []string{
+		"8dacf84e23101332cf3ca037ef13",
+		"28001b0601970d",
+	} {
+		m := testMsg(t, msg)
+
+		_, err := m.Emergency()
+		if !errors.Is(err, adsb.ErrNotAvailable) {
+			t.Errorf("%s: expected ErrNotAvailable, received %v", msg, err)
+		}
+
+		_, err = m.RA()
+		if !errors.Is(err, adsb.ErrNotAvailable) {
+			t.Errorf("%s: expected ErrNotAvailable, received %v", msg, err)
+		}
+	}
+}
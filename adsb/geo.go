@@ -0,0 +1,197 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"math"
+)
+
+// Earth model constants. Great circle calculations use a spherical
+// earth of mean radius and slant range calculations use the WGS-84
+// ellipsoid.
+const (
+	earthRadius  = 6371008.8         // mean earth radius in meters
+	wgs84A       = 6378137.0         // WGS-84 semi-major axis in meters
+	wgs84F       = 1 / 298.257223563 // WGS-84 flattening
+	meterPerNM   = 1852.0            // meters per nautical mile
+	meterPerFoot = 0.3048            // meters per foot
+)
+
+// Position is a geographic position with an optional altitude.
+type Position struct {
+	Lat      float64 // latitude in degrees, positive north
+	Lon      float64 // longitude in degrees, positive east
+	Alt      int64   // altitude in feet
+	AltValid bool    // altitude is available
+}
+
+// NewPosition returns a Position from a slice in the format
+// [latitude, longitude].
+func NewPosition(ll []float64) (*Position, error) {
+	err := checkRef(ll)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Position{Lat: ll[0], Lon: ll[1]}, nil
+}
+
+// Slice returns the position in the format [latitude, longitude].
+func (p Position) Slice() []float64 {
+	return []float64{p.Lat, p.Lon}
+}
+
+// Distance returns the great circle distance to q in nautical miles.
+func (p Position) Distance(q Position) float64 {
+	lat1, lon1 := p.radians()
+	lat2, lon2 := q.radians()
+
+	a := math.Pow(math.Sin((lat2-lat1)/2), 2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin((lon2-lon1)/2), 2)
+
+	return 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a)) * earthRadius / meterPerNM
+}
+
+// Bearing returns the initial true bearing along the great circle to q
+// in degrees.
+func (p Position) Bearing(q Position) float64 {
+	lat1, lon1 := p.radians()
+	lat2, lon2 := q.radians()
+
+	y := math.Sin(lon2-lon1) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) -
+		math.Sin(lat1)*math.Cos(lat2)*math.Cos(lon2-lon1)
+
+	return mod(math.Atan2(y, x)*180/math.Pi, 360)
+}
+
+// Destination returns the position reached by travelling the distance
+// in nautical miles along the great circle with the initial true
+// bearing in degrees. The altitude is unchanged.
+func (p Position) Destination(bearing float64, distance float64) Position {
+	lat1, lon1 := p.radians()
+	brg := bearing * math.Pi / 180
+	d := distance * meterPerNM / earthRadius
+
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(d) +
+		math.Cos(lat1)*math.Sin(d)*math.Cos(brg))
+	lon2 := lon1 + math.Atan2(math.Sin(brg)*math.Sin(d)*math.Cos(lat1),
+		math.Cos(d)-math.Sin(lat1)*math.Sin(lat2))
+
+	q := p
+	q.Lat = lat2 * 180 / math.Pi
+	q.Lon = normLon(lon2 * 180 / math.Pi)
+
+	return q
+}
+
+// Midpoint returns the position halfway along the great circle to q.
+// The altitude is the mean altitude if both altitudes are available.
+func (p Position) Midpoint(q Position) Position {
+	lat1, lon1 := p.radians()
+	lat2, lon2 := q.radians()
+
+	bx := math.Cos(lat2) * math.Cos(lon2-lon1)
+	by := math.Cos(lat2) * math.Sin(lon2-lon1)
+
+	lat := math.Atan2(math.Sin(lat1)+math.Sin(lat2),
+		math.Hypot(math.Cos(lat1)+bx, by))
+	lon := lon1 + math.Atan2(by, math.Cos(lat1)+bx)
+
+	m := Position{Lat: lat * 180 / math.Pi, Lon: normLon(lon * 180 / math.Pi)}
+
+	if p.AltValid && q.AltValid {
+		m.Alt = (p.Alt + q.Alt) / 2
+		m.AltValid = true
+	}
+
+	return m
+}
+
+// SlantRange returns the straight line distance from a receiver at rx
+// in nautical miles. Both positions must include an altitude.
+func (p Position) SlantRange(rx Position) (float64, error) {
+	if !p.AltValid || !rx.AltValid {
+		return 0, newError(ErrNotAvailable, "error calculating slant range")
+	}
+
+	d := p.ecef()
+	r := rx.ecef()
+
+	return math.Sqrt(math.Pow(d[0]-r[0], 2)+math.Pow(d[1]-r[1], 2)+
+		math.Pow(d[2]-r[2], 2)) / meterPerNM, nil
+}
+
+// Elevation returns the elevation angle above the horizon of a
+// receiver at rx in degrees. Both positions must include an altitude.
+func (p Position) Elevation(rx Position) (float64, error) {
+	if !p.AltValid || !rx.AltValid {
+		return 0, newError(ErrNotAvailable, "error calculating elevation")
+	}
+
+	d := p.ecef()
+	r := rx.ecef()
+
+	for i := range d {
+		d[i] -= r[i]
+	}
+
+	rng := math.Sqrt(d[0]*d[0] + d[1]*d[1] + d[2]*d[2])
+	if rng == 0 {
+		return 0, newError(nil, "error calculating elevation: positions are equal")
+	}
+
+	lat, lon := rx.radians()
+
+	// component of the line of sight along the ellipsoid normal
+	up := d[0]*math.Cos(lat)*math.Cos(lon) + d[1]*math.Cos(lat)*math.Sin(lon) +
+		d[2]*math.Sin(lat)
+
+	return math.Asin(up/rng) * 180 / math.Pi, nil
+}
+
+// radians returns the latitude and longitude in radians.
+func (p Position) radians() (float64, float64) {
+	return p.Lat * math.Pi / 180, p.Lon * math.Pi / 180
+}
+
+// ecef returns the earth-centered, earth-fixed coordinates of the
+// position in meters.
+func (p Position) ecef() [3]float64 {
+	lat, lon := p.radians()
+	h := float64(p.Alt) * meterPerFoot
+
+	e2 := wgs84F * (2 - wgs84F)
+	n := wgs84A / math.Sqrt(1-e2*math.Pow(math.Sin(lat), 2))
+
+	return [3]float64{
+		(n + h) * math.Cos(lat) * math.Cos(lon),
+		(n + h) * math.Cos(lat) * math.Sin(lon),
+		(n*(1-e2) + h) * math.Sin(lat),
+	}
+}
+
+// normLon returns a longitude in the range -180 to 180 degrees.
+func normLon(lon float64) float64 {
+	return mod(lon+180, 360) - 180
+}
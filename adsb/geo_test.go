@@ -0,0 +1,253 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb_test
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+
+	"kreklow.us/go/go-adsb/adsb"
+)
+
+// TestPosition runs the test cases for geographic positions.
+func TestPosition(t *testing.T) {
+	t.Run("New", testPositionNew)
+	t.Run("Distance", testPositionDistance)
+	t.Run("Destination", testPositionDestination)
+	t.Run("Midpoint", testPositionMidpoint)
+	t.Run("SlantRange", testPositionSlantRange)
+	t.Run("CPR", testPositionCPR)
+}
+
+func testPositionNew(t *testing.T) {
+	p, err := adsb.NewPosition([]float64{52.5, -4.25})
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	if *p != (adsb.Position{Lat: 52.5, Lon: -4.25}) {
+		t.Errorf("received %+v", *p)
+	}
+
+	if !slices.Equal(p.Slice(), []float64{52.5, -4.25}) {
+		t.Errorf("received %v, expected [52.5 -4.25]", p.Slice())
+	}
+
+	p, err = adsb.NewPosition([]float64{91, 0})
+	if err == nil || err.Error() != "latitude out of range (-90 to 90)" {
+		t.Error("received unexpected error", err)
+	}
+
+	if p != nil {
+		t.Error("received unexpected data")
+	}
+}
+
+func testPositionDistance(t *testing.T) {
+	tests := []struct {
+		p, q     adsb.Position
+		dist     float64
+		bearing  float64
+		reverse  float64
+		midpoint adsb.Position
+	}{
+		{
+			adsb.Position{Lat: 50.0359, Lon: -5.4253},
+			adsb.Position{Lat: 58.3838, Lon: -3.0412},
+			508.072, 8.522, 190.461,
+			adsb.Position{Lat: 54.2157, Lon: -4.3539},
+		},
+		{
+			adsb.Position{Lat: 51.47, Lon: -0.4543},
+			adsb.Position{Lat: 40.6413, Lon: -73.7781},
+			2991.371, 287.943, 51.353,
+			adsb.Position{Lat: 52.2167, Lon: -41.3027},
+		},
+		{
+			adsb.Position{Lat: 0, Lon: 179.5},
+			adsb.Position{Lat: 0, Lon: -179.5},
+			60.04, 90, 270,
+			adsb.Position{Lat: 0, Lon: -180},
+		},
+	}
+
+	for _, tc := range tests {
+		testFloat(t, "Distance", tc.p.Distance(tc.q), tc.dist, 0.001)
+		testFloat(t, "Bearing", tc.p.Bearing(tc.q), tc.bearing, 0.001)
+		testFloat(t, "Reverse", tc.q.Bearing(tc.p), tc.reverse, 0.001)
+
+		m := tc.p.Midpoint(tc.q)
+		testFloat(t, "Lat", m.Lat, tc.midpoint.Lat, 0.0001)
+		testFloat(t, "Lon", m.Lon, tc.midpoint.Lon, 0.0001)
+		testFloat(t, "Half", tc.p.Distance(m), tc.dist/2, 0.001)
+	}
+}
+
+func testPositionDestination(t *testing.T) {
+	p := adsb.Position{Lat: 51.47, Lon: -0.4543, Alt: 1000, AltValid: true}
+
+	d := p.Destination(287.943188, 2991.370934)
+	testFloat(t, "Lat", d.Lat, 40.6413, 0.0001)
+	testFloat(t, "Lon", d.Lon, -73.7781, 0.0001)
+
+	if d.Alt != 1000 || !d.AltValid {
+		t.Errorf("received %d, expected 1000", d.Alt)
+	}
+
+	d = adsb.Position{Lat: 0, Lon: 179.5}.Destination(90, 60.04)
+	testFloat(t, "Lat", d.Lat, 0, 0.0001)
+	testFloat(t, "Lon", d.Lon, -179.5, 0.0001)
+
+	d = adsb.Position{Lat: 89, Lon: 0}.Destination(0, 120.0868)
+	testFloat(t, "Lat", d.Lat, 89, 0.0001)
+	testFloat(t, "Lon", d.Lon, -180, 0.0001)
+}
+
+func testPositionMidpoint(t *testing.T) {
+	p := adsb.Position{Lat: 10, Lon: 10, Alt: 1000, AltValid: true}
+	q := adsb.Position{Lat: 20, Lon: 10, Alt: 3000, AltValid: true}
+
+	m := p.Midpoint(q)
+	testFloat(t, "Lat", m.Lat, 15, 0.0001)
+	testFloat(t, "Lon", m.Lon, 10, 0.0001)
+
+	if m.Alt != 2000 || !m.AltValid {
+		t.Errorf("received %d, expected 2000", m.Alt)
+	}
+
+	q.AltValid = false
+
+	m = p.Midpoint(q)
+	if m.Alt != 0 || m.AltValid {
+		t.Errorf("received %d, expected no altitude", m.Alt)
+	}
+}
+
+func testPositionSlantRange(t *testing.T) {
+	rx := adsb.Position{Lat: 52, Lon: 4, AltValid: true}
+	ac := adsb.Position{Lat: 52.5, Lon: 4.5, Alt: 35000, AltValid: true}
+
+	rng, err := ac.SlantRange(rx)
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	testFloat(t, "SlantRange", rng, 35.7444, 0.0001)
+
+	el, err := ac.Elevation(rx)
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	testFloat(t, "Elevation", el, 8.9805, 0.0001)
+
+	rng, err = rx.SlantRange(rx)
+	if err != nil || rng != 0 {
+		t.Errorf("received %f, %v, expected 0", rng, err)
+	}
+
+	_, err = rx.Elevation(rx)
+	if err == nil || err.Error() != "error calculating elevation: positions are equal" {
+		t.Error("received unexpected error", err)
+	}
+
+	ac.AltValid = false
+
+	_, err = ac.SlantRange(rx)
+	if !errors.Is(err, adsb.ErrNotAvailable) {
+		t.Error("received unexpected error", err)
+	}
+
+	_, err = ac.Elevation(rx)
+	if !errors.Is(err, adsb.ErrNotAvailable) {
+		t.Error("received unexpected error", err)
+	}
+}
+
+// test the Position forms of the CPR functions.
+func testPositionCPR(t *testing.T) {
+	c1 := testSurfaceCPR(t, "8d40621d58c386435cc412692ad6")
+	c0 := testSurfaceCPR(t, "8d40621d58c382d690c8ac2863a7")
+
+	p, err := adsb.GlobalPosition(c1, c0)
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	testFloat(t, "Lat", p.Lat, 52.2572, 0.0001)
+	testFloat(t, "Lon", p.Lon, 3.9194, 0.0001)
+
+	c, err := adsb.EncodePosition(*p, 0, 17)
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	if c.Lat != c0.Lat || c.Lon != c0.Lon {
+		t.Errorf("received %+v, expected %+v", *c, *c0)
+	}
+
+	p, err = c1.LocalPosition(adsb.Position{Lat: 52.258, Lon: 3.918})
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	testFloat(t, "Lat", p.Lat, 52.2658, 0.0001)
+	testFloat(t, "Lon", p.Lon, 3.9389, 0.0001)
+
+	s1 := testSurfaceCPR(t, "8c4841753aab238733c8cd4020b1")
+	s2 := testSurfaceCPR(t, "8c4841753a8a35323faebdac702d")
+
+	p, err = adsb.GlobalSurfacePosition(s1, s2, adsb.Position{Lat: 51.990, Lon: 4.375})
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	testFloat(t, "Lat", p.Lat, 52.32061, 0.00001)
+	testFloat(t, "Lon", p.Lon, 4.73473, 0.00001)
+
+	_, err = adsb.GlobalPosition(c1, c1)
+	if err == nil {
+		t.Error("expected error, received nil")
+	}
+
+	_, err = adsb.GlobalSurfacePosition(s1, s2, adsb.Position{Lat: 91})
+	if err == nil {
+		t.Error("expected error, received nil")
+	}
+
+	_, err = c1.LocalPosition(adsb.Position{Lat: -91})
+	if err == nil {
+		t.Error("expected error, received nil")
+	}
+}
+
+// testFloat compares a float value within a tolerance.
+func testFloat(t *testing.T, name string, val float64, exp float64, tol float64) {
+	t.Helper()
+
+	if math.Abs(val-exp) > tol {
+		t.Errorf("%s: received %f, expected %f", name, val, exp)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb_test
+
+import (
+	"errors"
+	"testing"
+
+	"kreklow.us/go/go-adsb/adsb"
+)
+
+// testMsg returns a Message loaded from a hexadecimal string.
+func testMsg(t *testing.T, msg string) *adsb.Message {
+	t.Helper()
+
+	m := new(adsb.Message)
+
+	err := m.UnmarshalText([]byte(msg))
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	return m
+}
+
+// testNotAvailable tests that a decoder returns an error wrapping
+// ErrNotAvailable and no data for an identification message and a
+// surveillance reply.
+func testNotAvailable[T any](t *testing.T, decode func(m *adsb.Message) (*T, error)) {
+	t.Helper()
+
+	for _, msg := range []string{
+		"8dacf84e23101332cf3ca037ef13",
+		"20001910bc45e9",
+	} {
+		v, err := decode(testMsg(t, msg))
+		if !errors.Is(err, adsb.ErrNotAvailable) {
+			t.Errorf("%s: expected ErrNotAvailable, received %v", msg, err)
+		}
+
+		if v != nil {
+			t.Errorf("%s: received unexpected data", msg)
+		}
+	}
+}
@@ -25,8 +25,10 @@ package adsb
 import (
 	"bytes"
 	"errors"
+	"fmt"
 
 	"github.com/ccoveille/go-safecast/v2"
+	"kreklow.us/go/go-adsb/adsbtype"
 )
 
 // Message provides a high-level abstraction for ADS-B messages. The
@@ -68,6 +70,36 @@ func (m *Message) UnmarshalBinary(data []byte) error {
 	return m.validateRaw()
 }
 
+// MarshalText implements the TextMarshaler interface, returning the
+// message as a string of hexadecimal digits. A Message without data
+// returns an error rather than an empty string, which could not be
+// unmarshaled again; optional messages should be stored as a *Message.
+func (m Message) MarshalText() ([]byte, error) {
+	if m.raw == nil {
+		return nil, newError(nil, "no data loaded")
+	}
+
+	return m.raw.MarshalText()
+}
+
+// UnmarshalText implements the TextUnmarshaler interface, storing the
+// supplied hexadecimal data in the Message. The message may optionally
+// be framed as "*...;" in the AVR format.
+//
+// Errors are returned in the same manner as UnmarshalBinary.
+func (m *Message) UnmarshalText(text []byte) error {
+	if m.raw == nil {
+		m.raw = new(RawMessage)
+	}
+
+	err := m.raw.UnmarshalText(text)
+	if err != nil {
+		return err
+	}
+
+	return m.validateRaw()
+}
+
 // Raw returns the underlying RawMessage. The content of the RawMessage
 // will be overwritten by a subsequent call to UnmarsahalBinary.
 func (m *Message) Raw() *RawMessage {
@@ -95,37 +127,91 @@ func (m *Message) ICAO() (uint64, error) {
 	return ap ^ m.raw.Parity(), nil
 }
 
-// Alt returns the altitude.
+// Alt returns the altitude in feet. Extended squitter airborne
+// positions with type codes 20 to 22 report GNSS height rather than
+// barometric altitude, use AltType to distinguish between the two.
+// Metric altitudes are converted to feet, use Altitude to obtain the
+// reported units and encoding.
 func (m *Message) Alt() (int64, error) {
+	alt, err := m.Altitude()
+	if err != nil {
+		return 0, err
+	}
+
+	return alt.Feet, nil
+}
+
+// Altitude returns the altitude along with the units, encoding and type
+// in which it was reported.
+func (m *Message) Altitude() (*Altitude, error) {
 	df, err := m.raw.DF()
 	if err != nil {
-		return 0, newError(err, "error retrieving altitude")
+		return nil, newError(err, "error retrieving altitude")
 	}
 
 	switch df {
 	case 0, 4, 16, 20:
 		ac, err := m.raw.AC()
 		if err != nil {
-			return 0, newError(err, "error retrieving altitude")
+			return nil, newError(err, "error retrieving altitude")
 		}
 
 		return decodeAC(ac)
 	case 17, 18:
+		if m.coarse() {
+			return decodeESAlt(m.raw.esbits(6, 17))
+		}
+
 		alt, err := m.raw.ESAltitude()
 		if err != nil {
-			return 0, newError(err, "error retrieving altitude")
+			return nil, newError(err, "error retrieving altitude")
+		}
+
+		if tc, _ := m.raw.ESType(); tc >= 20 {
+			return decodeGNSSAlt(alt)
 		}
 
 		return decodeESAlt(alt)
 	default:
-		return 0, newError(ErrNotAvailable, "error retrieving altitude")
+		return nil, newError(ErrNotAvailable, "error retrieving altitude")
+	}
+}
+
+// AltType returns the type of altitude reported by Alt.
+func (m *Message) AltType() (adsbtype.ATS, error) {
+	df, err := m.raw.DF()
+	if err != nil {
+		return 0, newError(err, "error retrieving altitude type")
+	}
+
+	switch df {
+	case 0, 4, 16, 20:
+		return adsbtype.ATS0, nil
+	case 17, 18:
+		if m.coarse() {
+			return adsbtype.ATS0, nil
+		}
+
+		_, err := m.raw.ESAltitude()
+		if err != nil {
+			return 0, newError(err, "error retrieving altitude type")
+		}
+
+		if tc, _ := m.raw.ESType(); tc >= 20 {
+			return adsbtype.ATS1, nil
+		}
+
+		return adsbtype.ATS0, nil
+	default:
+		return 0, newError(ErrNotAvailable, "error retrieving altitude type")
 	}
 }
 
 var callChars = []byte(
 	"?ABCDEFGHIJKLMNOPQRSTUVWXYZ????? ???????????????0123456789??????")
 
-// Call returns the callsign.
+// Call returns the callsign. Comm-B identification replies are validated
+// with DecodeBDS20.
 func (m *Message) Call() (string, error) {
 	df, err := m.raw.DF()
 	if err != nil {
@@ -139,9 +225,14 @@ func (m *Message) Call() (string, error) {
 			return "", newError(ErrNotAvailable, "error retrieving callsign")
 		}
 	case 20, 21:
-		if m.raw.Bits(33, 40) != 0x20 {
+		mb, _ := m.raw.MB()
+
+		call, err := DecodeBDS20(mb)
+		if err != nil {
 			return "", newError(ErrNotAvailable, "error retrieving callsign")
 		}
+
+		return call, nil
 	default:
 		return "", newError(ErrNotAvailable, "error retrieving callsign")
 	}
@@ -158,17 +249,64 @@ func (m *Message) Call() (string, error) {
 	return string(bytes.TrimRight(call, " ")), nil
 }
 
+// Category returns the aircraft emitter category from an extended
+// squitter identification message. Type code 1 designates the reserved
+// category set D, which is returned without a description.
+func (m *Message) Category() (adsbtype.AcCat, error) {
+	tc, err := m.raw.ESType()
+	if err != nil {
+		return "", newError(err, "error retrieving category")
+	}
+
+	if tc < 1 || tc > 4 {
+		return "", newError(ErrNotAvailable, "error retrieving category")
+	}
+
+	set := 'A' + 4 - rune(tc)
+
+	return adsbtype.AcCat(fmt.Sprintf("%c%d", set, m.raw.esbits(6, 8))), nil
+}
+
+var wtcTbl = map[adsbtype.AcCat]adsbtype.WTC{
+	adsbtype.A1: adsbtype.WTCL,
+	adsbtype.A2: adsbtype.WTCM,
+	adsbtype.A3: adsbtype.WTCM,
+	adsbtype.A4: adsbtype.WTCM,
+	adsbtype.A5: adsbtype.WTCH,
+	adsbtype.B1: adsbtype.WTCL,
+	adsbtype.B2: adsbtype.WTCL,
+	adsbtype.B3: adsbtype.WTCL,
+	adsbtype.B4: adsbtype.WTCL,
+}
+
+// WakeCategory returns the wake turbulence category derived from the
+// aircraft emitter category. Categories which do not imply a weight
+// class, such as rotorcraft or unmanned aerial vehicles, return an error
+// wrapping ErrNotAvailable.
+func (m *Message) WakeCategory() (adsbtype.WTC, error) {
+	cat, err := m.Category()
+	if err != nil {
+		return "", newError(err, "error retrieving wake category")
+	}
+
+	wtc, ok := wtcTbl[cat]
+	if !ok {
+		return "", newErrorf(ErrNotAvailable,
+			"error retrieving wake category from %s", string(cat))
+	}
+
+	return wtc, nil
+}
+
 var sqkTbl = [][]int{
-	{25, 23, 21},
-	{31, 29, 27},
-	{24, 22, 20},
-	{32, 30, 28},
+	{6, 4, 2},
+	{12, 10, 8},
+	{5, 3, 1},
+	{13, 11, 9},
 }
 
 // Sqk returns the squawk code.
-func (m *Message) Sqk() ([]byte, error) {
-	sqk := make([]byte, 0, 4)
-
+func (m *Message) Sqk() (Squawk, error) {
 	df, err := m.raw.DF()
 	if err != nil {
 		return nil, newError(err, "error retrieving squawk")
@@ -180,25 +318,39 @@ func (m *Message) Sqk() ([]byte, error) {
 		return nil, newError(ErrNotAvailable, "error retrieving squawk")
 	}
 
-	sqk = sqk[0:4]
+	return m.modeA(20), nil
+}
+
+// modeA decodes the 13 bit Mode A code beginning at bit n into a slice
+// of four octal digits.
+func (m *Message) modeA(n int) Squawk {
+	sqk := make(Squawk, 4)
 
 	for i, v := range sqkTbl {
 		for _, x := range v {
 			sqk[i] <<= 1
-			sqk[i] |= m.raw.Bit(x)
+			sqk[i] |= m.raw.Bit(n + x - 1)
 		}
 	}
 
-	return sqk, nil
+	return sqk
 }
 
-// CPR returns the compact position report.
+// CPR returns the compact position report. Surface positions are
+// returned with the 19 bit encoding and coarse format TIS-B positions
+// with the 12 bit encoding.
 func (m *Message) CPR() (*CPR, error) {
 	df, err := m.raw.DF()
 	if err != nil {
 		return nil, newError(err, "error retrieving position")
 	}
 
+	if m.coarse() {
+		return m.coarseCPR(), nil
+	}
+
+	var nb uint8
+
 	switch df {
 	case 17, 18:
 		tc, err := m.raw.ESType()
@@ -206,7 +358,12 @@ func (m *Message) CPR() (*CPR, error) {
 			return nil, newError(err, "error retrieving position")
 		}
 
-		if tc < 9 || tc > 18 {
+		switch {
+		case tc >= 5 && tc <= 8:
+			nb = 19
+		case tc >= 9 && tc <= 18, tc >= 20 && tc <= 22:
+			nb = 17
+		default:
 			return nil, newError(ErrNotAvailable, "error retrieving position")
 		}
 	default:
@@ -214,7 +371,7 @@ func (m *Message) CPR() (*CPR, error) {
 	}
 
 	c := new(CPR)
-	c.Nb = 17
+	c.Nb = nb
 	c.T = m.raw.Bit(53)
 	c.F = m.raw.Bit(54)
 	c.Lat = safecast.MustConvert[uint32](m.raw.Bits(55, 71))
@@ -25,11 +25,13 @@ package adsb_test
 import (
 	"bytes"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"math/big"
 	"testing"
 
 	"kreklow.us/go/go-adsb/adsb"
+	"kreklow.us/go/go-adsb/adsbtype"
 )
 
 func TestMessageErrors(t *testing.T) {
@@ -331,6 +333,94 @@ func testMsgCPRErr(t *testing.T) {
 	}
 }
 
+func TestMessageText(t *testing.T) {
+	t.Run("JSON", testMsgTextJSON)
+	t.Run("JSONValue", testMsgTextJSONValue)
+	t.Run("Unsupported", testMsgTextUnsupported)
+	t.Run("NoData", testMsgTextNoData)
+}
+
+func testMsgTextJSON(t *testing.T) {
+	var v struct {
+		Msg *adsb.Message
+	}
+
+	err := json.Unmarshal([]byte(`{"Msg":"*8dacf84e23101332cf3ca037ef13;"}`), &v)
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	call, err := v.Msg.Call()
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	if call != "DAL2332" {
+		t.Errorf("expected DAL2332, received %s", call)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	if string(b) != `{"Msg":"8dacf84e23101332cf3ca037ef13"}` {
+		t.Errorf("received unexpected data %s", b)
+	}
+}
+
+func testMsgTextJSONValue(t *testing.T) {
+	var v struct {
+		Msg adsb.Message
+		Raw adsb.RawMessage
+	}
+
+	err := json.Unmarshal([]byte(`{"Msg":"8dacf84e23101332cf3ca037ef13","Raw":"5daa234a912889"}`), &v)
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	if string(b) != `{"Msg":"8dacf84e23101332cf3ca037ef13","Raw":"5daa234a912889"}` {
+		t.Errorf("received unexpected data %s", b)
+	}
+}
+
+func testMsgTextUnsupported(t *testing.T) {
+	m := new(adsb.Message)
+
+	err := m.UnmarshalText([]byte("980000000000ff000000000000ff"))
+	if err == nil {
+		t.Fatal("received nil, expected error")
+	}
+
+	if !errors.Is(err, adsb.ErrUnsupported) {
+		t.Error("expected error type ErrUnsupported not received")
+	}
+
+	err = m.UnmarshalText([]byte("zz"))
+	if err == nil {
+		t.Fatal("received nil, expected error")
+	}
+}
+
+func testMsgTextNoData(t *testing.T) {
+	m := new(adsb.Message)
+
+	_, err := m.MarshalText()
+	if err == nil {
+		t.Fatal("received nil, expected error")
+	}
+
+	if err.Error() != "no data loaded" {
+		t.Error("received unexpected error", err)
+	}
+}
+
 type testCase struct {
 	Msg string
 
@@ -371,6 +461,7 @@ func TestDecode(t *testing.T) {
 	t.Run("DF17 Position Global", testDF17PosGlobal)
 	t.Run("DF17 Position Global Reverse", testDF17PosGlobalRev)
 	t.Run("DF17 Identity", testDF17Ident)
+	t.Run("DF17 GNSS Position", testDF17GNSSPos)
 	t.Run("DF20", testDF20)
 	t.Run("DF21", testDF21)
 	t.Run("DF24", testDF24)
@@ -378,8 +469,8 @@ func TestDecode(t *testing.T) {
 
 // TestDecodeErrors runs test cases for message decoding errors.
 func TestDecodeErrors(t *testing.T) {
-	t.Run("MetricAltitude", testAltErrMetric)
 	t.Run("InvalidAltitude", testAltErrInvalid)
+	t.Run("InvalidGNSSAltitude", testAltErrGNSS)
 }
 
 // test DF0 air-to-air surveillance.
@@ -671,6 +762,36 @@ func testDF17PosGlobalRev(t *testing.T) {
 	testDecode(t, tc)
 }
 
+// test DF17 extended squitter position with GNSS height.
+func testDF17GNSSPos(t *testing.T) {
+	tc := &testCase{
+		Msg: "8da9450da03e8138e8638c9e03a9",
+
+		DF: 17,
+		CA: 5,
+		FS: -1,
+		VS: -1,
+
+		TC:  20,
+		SS:  0,
+		Cat: "",
+
+		CPR:      true,
+		LocalPos: true,
+		RefPt:    []float64{43.14, -89.33},
+
+		Lat: 43.83300781,
+		Lon: -90.46484375,
+
+		ICAO: 0xa9450d,
+		Alt:  3281,
+		Sqk:  []byte{},
+		Call: "",
+	}
+
+	testDecode(t, tc)
+}
+
 // test DF17 extended squitter identity.
 func testDF17Ident(t *testing.T) {
 	tc := &testCase{
@@ -714,6 +835,7 @@ func testDecode(t *testing.T, tc *testCase) {
 	testICAO(t, tc, msg)
 	testSqk(t, tc, msg)
 	testCall(t, tc, msg)
+	testCategory(t, tc, msg)
 	testAlt(t, tc, msg)
 	testCPR(t, tc, msg)
 }
@@ -876,6 +998,21 @@ func testCall(t *testing.T, tc *testCase, msg *adsb.Message) {
 	}
 }
 
+func testCategory(t *testing.T, tc *testCase, msg *adsb.Message) {
+	t.Helper()
+
+	cat, err := msg.Category()
+	if err != nil {
+		if tc.Cat != "" || tc.Cat == "" && !errors.Is(err, adsb.ErrNotAvailable) {
+			t.Fatal("received unexpected error", err)
+		}
+	}
+
+	if string(cat) != tc.Cat {
+		t.Errorf("Cat: received %s, expected %s", cat, tc.Cat)
+	}
+}
+
 func testAlt(t *testing.T, tc *testCase, msg *adsb.Message) {
 	t.Helper()
 
@@ -891,23 +1028,23 @@ func testAlt(t *testing.T, tc *testCase, msg *adsb.Message) {
 	}
 }
 
-// test DF4 with metric altitude.
-func testAltErrMetric(t *testing.T) {
+// test DF4 with invalid altitude.
+func testAltErrInvalid(t *testing.T) {
 	tc := &testCase{
-		Msg: "2000046210fc86",
+		Msg: "2000002210fc86",
 
-		AltError: "metric altitude not supported",
+		AltError: "invalid altitude value",
 	}
 
 	testDecodeErr(t, tc)
 }
 
-// test DF4 with invalid altitude.
-func testAltErrInvalid(t *testing.T) {
+// test DF17 with empty GNSS height.
+func testAltErrGNSS(t *testing.T) {
 	tc := &testCase{
-		Msg: "2000002210fc86",
+		Msg: "8da9450da0000138e8638c850fd2",
 
-		AltError: "invalid altitude value",
+		AltError: "invalid altitude data",
 	}
 
 	testDecodeErr(t, tc)
@@ -951,3 +1088,110 @@ func testAltError(t *testing.T, tc *testCase, msg *adsb.Message) {
 		t.Errorf("expected %s, received %s", tc.AltError, err)
 	}
 }
+
+// TestAltType tests the altitude type of each message format.
+func TestAltType(t *testing.T) {
+	for msg, ats := range map[string]adsbtype.ATS{
+		"02e19718e70f6c":               adsbtype.ATS0,
+		"a0000f9820057273df8d20e2cf30": adsbtype.ATS0,
+		"8da9450d60bde138e8638c939134": adsbtype.ATS0,
+		"8da9450da03e8138e8638c9e03a9": adsbtype.ATS1,
+	} {
+		m := testMsg(t, msg)
+
+		a, err := m.AltType()
+		if err != nil {
+			t.Fatal("received unexpected error", err)
+		}
+
+		if a != ats {
+			t.Errorf("%s: received %s, expected %s", msg, a, ats)
+		}
+	}
+
+	for _, msg := range []string{
+		"28001b0601970d",
+		"8dacf84e23101332cf3ca037ef13",
+	} {
+		m := testMsg(t, msg)
+
+		_, err := m.AltType()
+		if !errors.Is(err, adsb.ErrNotAvailable) {
+			t.Errorf("%s: expected ErrNotAvailable, received %v", msg, err)
+		}
+	}
+}
+
+// TestWakeCategory tests the derived wake turbulence category.
+func TestWakeCategory(t *testing.T) {
+	for msg, exp := range map[string][]string{
+		"8d4ca123250815f1cb3820f2ed3d": {"A5", "H"},
+		"8dacf84e23101332cf3ca037ef13": {"A3", "M"},
+		"8dabc1231118f30c3d7345495c3d": {"C1", ""},
+		"8dabc1241e3b1cb354182088da59": {"B6", ""},
+		"8dabc1250b5054d48208203eab40": {"D3", ""},
+	} {
+		m := testMsg(t, msg)
+
+		cat, err := m.Category()
+		if err != nil {
+			t.Fatal("received unexpected error", err)
+		}
+
+		if string(cat) != exp[0] {
+			t.Errorf("Cat: received %s, expected %s", cat, exp[0])
+		}
+
+		wtc, err := m.WakeCategory()
+		if exp[1] == "" && !errors.Is(err, adsb.ErrNotAvailable) {
+			t.Errorf("%s: expected ErrNotAvailable, received %v", msg, err)
+		} else if exp[1] != "" && err != nil {
+			t.Fatal("received unexpected error", err)
+		}
+
+		if string(wtc) != exp[1] {
+			t.Errorf("WTC: received %s, expected %s", wtc, exp[1])
+		}
+	}
+}
+
+// TestAltitude tests the units, encoding and type of altitudes.
+func TestAltitude(t *testing.T) {
+	for msg, exp := range map[string]adsb.Altitude{
+		"20001910bc45e9": {
+			Value: 39000, Feet: 39000, Encoding: adsbtype.AltEnc0,
+		},
+		"2000102a10fc86": {
+			Value: 1300, Feet: 1300, Encoding: adsbtype.AltEnc1,
+		},
+		"2000046210fc86": {
+			Value: 546, Feet: 1791, Metric: true, Encoding: adsbtype.AltEnc2,
+		},
+		"8da9450da03e8138e8638c9e03a9": {
+			Value: 1000, Feet: 3281, Metric: true, Encoding: adsbtype.AltEnc2,
+			Type: adsbtype.ATS1,
+		},
+	} {
+		m := testMsg(t, msg)
+
+		a, err := m.Altitude()
+		if err != nil {
+			t.Fatal("received unexpected error", err)
+		}
+
+		if *a != exp {
+			t.Errorf("%s: received %+v, expected %+v", msg, *a, exp)
+		}
+	}
+
+	m := testMsg(t, "28001b0601970d")
+
+	a, err := m.Altitude()
+	if !errors.Is(err, adsb.ErrNotAvailable) {
+		t.Error("expected ErrNotAvailable, received", err)
+	}
+
+	if a != nil {
+		t.Error("received unexpected data")
+	}
+}
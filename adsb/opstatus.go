@@ -0,0 +1,200 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import "github.com/ccoveille/go-safecast/v2"
+
+// OpStatus is an extended squitter aircraft operational status report.
+// Subtype 0 reports airborne status and subtype 1 reports surface
+// status.
+//
+// The capability class and operational mode codes are decoded for
+// ADS-B version numbers 1 and 2 only. Fields which do not apply to the
+// subtype or version number of the message are left empty.
+type OpStatus struct {
+	Subtype uint8  // operational status subtype (0 or 1)
+	Version uint8  // ADS-B version number
+	CC      uint16 // capability class codes
+	OM      uint16 // operational mode codes
+
+	ACAS     bool  // ACAS operational (airborne)
+	CDTI     bool  // cockpit display of traffic information (version 1)
+	ES1090In bool  // 1090ES receive capability (version 2)
+	UATIn    bool  // UAT receive capability (version 2)
+	ARV      bool  // air-referenced velocity report capability (airborne)
+	TS       bool  // target state report capability (airborne)
+	TC       uint8 // target change report capability (airborne)
+	POA      bool  // position offset applied (surface)
+	B2Low    bool  // transmit power less than 70 W (surface)
+	NACv     uint8 // navigation accuracy category for velocity (surface, version 2)
+
+	RAActive      bool  // ACAS resolution advisory active
+	Ident         bool  // IDENT switch active
+	ATC           bool  // receiving ATC services (version 1)
+	SingleAntenna bool  // single antenna (version 2)
+	SDA           uint8 // system design assurance (version 2)
+	AntennaOffset uint8 // GPS antenna offset code (surface, version 2)
+
+	NICSuppA bool  // NIC supplement A
+	NICSuppC bool  // NIC supplement C (surface, version 2)
+	NACp     uint8 // navigation accuracy category for position
+	GVA      uint8 // geometric vertical accuracy (airborne, version 2)
+	SIL      uint8 // source integrity level
+	SILSupp  bool  // SIL is per sample rather than per hour (version 2)
+	BAI      bool  // barometric altitude integrity, NICbaro (airborne)
+	TAH      bool  // ground track is track angle rather than heading (surface)
+	HRD      bool  // horizontal reference is magnetic rather than true north
+
+	LW     uint8   // aircraft length and width code (surface)
+	Length float64 // maximum aircraft length in meters (surface)
+	Width  float64 // maximum aircraft width in meters (surface)
+}
+
+// lwTbl contains the maximum length and width in meters for each
+// aircraft length and width code.
+var lwTbl = [][]float64{
+	{0, 0}, {15, 23}, {25, 28.5}, {25, 34},
+	{35, 33}, {35, 38}, {45, 39.5}, {45, 45},
+	{55, 45}, {55, 52}, {65, 59.5}, {65, 67},
+	{75, 72.5}, {75, 80}, {85, 80}, {85, 90},
+}
+
+// OpStatus returns the aircraft operational status report.
+func (m *Message) OpStatus() (*OpStatus, error) {
+	tc, err := m.raw.ESType()
+	if err != nil {
+		return nil, newError(err, "error retrieving operational status")
+	}
+
+	if tc != 31 {
+		return nil, newError(ErrNotAvailable, "error retrieving operational status")
+	}
+
+	st, _ := m.raw.ESSubtype()
+	if st > 1 {
+		return nil, newErrorf(nil,
+			"error retrieving operational status: unknown subtype %d", st)
+	}
+
+	s := new(OpStatus)
+	s.Subtype = safecast.MustConvert[uint8](st)
+	s.Version = safecast.MustConvert[uint8](m.raw.esbits(41, 43))
+	s.OM = safecast.MustConvert[uint16](m.raw.esbits(25, 40))
+
+	if st == 0 {
+		s.CC = safecast.MustConvert[uint16](m.raw.esbits(9, 24))
+	} else {
+		s.CC = safecast.MustConvert[uint16](m.raw.esbits(9, 20))
+		s.LW = safecast.MustConvert[uint8](m.raw.esbits(21, 24))
+		s.Length = lwTbl[s.LW][0]
+		s.Width = lwTbl[s.LW][1]
+	}
+
+	if s.Version == 0 {
+		return s, nil
+	}
+
+	m.decodeOpCapability(s)
+	m.decodeOpMode(s)
+
+	s.NICSuppA = m.raw.esbits(44, 44) == 1
+	s.NACp = safecast.MustConvert[uint8](m.raw.esbits(45, 48))
+	s.SIL = safecast.MustConvert[uint8](m.raw.esbits(51, 52))
+	s.HRD = m.raw.esbits(54, 54) == 1
+
+	if st == 0 {
+		s.BAI = m.raw.esbits(53, 53) == 1
+	} else {
+		s.TAH = m.raw.esbits(53, 53) == 1
+	}
+
+	if s.Version >= 2 {
+		if st == 0 {
+			s.GVA = safecast.MustConvert[uint8](m.raw.esbits(49, 50))
+		}
+
+		s.SILSupp = m.raw.esbits(55, 55) == 1
+	}
+
+	return s, nil
+}
+
+// decodeOpCapability decodes the capability class codes.
+func (m *Message) decodeOpCapability(s *OpStatus) {
+	if s.Version == 1 {
+		s.CDTI = m.raw.esbits(12, 12) == 1
+	} else {
+		s.ES1090In = m.raw.esbits(12, 12) == 1
+	}
+
+	if s.Subtype == 0 {
+		// version 1 reports "not ACAS", later versions report "ACAS"
+		if s.Version == 1 {
+			s.ACAS = m.raw.esbits(11, 11) == 0
+		} else {
+			s.ACAS = m.raw.esbits(11, 11) == 1
+		}
+
+		s.ARV = m.raw.esbits(15, 15) == 1
+		s.TS = m.raw.esbits(16, 16) == 1
+		s.TC = safecast.MustConvert[uint8](m.raw.esbits(17, 18))
+
+		if s.Version >= 2 {
+			s.UATIn = m.raw.esbits(19, 19) == 1
+		}
+
+		return
+	}
+
+	s.POA = m.raw.esbits(11, 11) == 1
+
+	if s.Version == 1 {
+		s.B2Low = m.raw.esbits(13, 13) == 1
+
+		return
+	}
+
+	s.B2Low = m.raw.esbits(15, 15) == 1
+	s.UATIn = m.raw.esbits(16, 16) == 1
+	s.NACv = safecast.MustConvert[uint8](m.raw.esbits(17, 19))
+	s.NICSuppC = m.raw.esbits(20, 20) == 1
+}
+
+// decodeOpMode decodes the operational mode codes.
+func (m *Message) decodeOpMode(s *OpStatus) {
+	s.RAActive = m.raw.esbits(27, 27) == 1
+	s.Ident = m.raw.esbits(28, 28) == 1
+
+	if s.Version == 1 {
+		s.ATC = m.raw.esbits(29, 29) == 1
+
+		return
+	}
+
+	s.SingleAntenna = m.raw.esbits(30, 30) == 1
+	s.SDA = safecast.MustConvert[uint8](m.raw.esbits(31, 32))
+
+	if s.Subtype == 1 {
+		s.AntennaOffset = safecast.MustConvert[uint8](m.raw.esbits(33, 40))
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb_test
+
+import (
+	"testing"
+
+	"kreklow.us/go/go-adsb/adsb"
+)
+
+// TestOpStatus runs the test cases for operational status decoding.
+func TestOpStatus(t *testing.T) {
+	t.Run("AirborneV2", testOpStatusAirV2)
+	t.Run("AirborneV1", testOpStatusAirV1)
+	t.Run("AirborneV3", testOpStatusAirV3)
+	t.Run("SurfaceV2", testOpStatusSurfV2)
+	t.Run("SurfaceV0", testOpStatusSurfV0)
+	t.Run("Unknown", testOpStatusUnknown)
+	t.Run("NotAvailable", testOpStatusNotAvailable)
+}
+
+// test version 2 airborne status.
+func testOpStatusAirV2(t *testing.T) {
+	testOpStatus(t, "8dabcdeff83340120049b80b55fb", &adsb.OpStatus{
+		Version:  2,
+		CC:       0x3340,
+		OM:       0x1200,
+		ACAS:     true,
+		ES1090In: true,
+		ARV:      true,
+		TS:       true,
+		TC:       1,
+		Ident:    true,
+		SDA:      2,
+		NACp:     9,
+		GVA:      2,
+		SIL:      3,
+		BAI:      true,
+	})
+}
+
+// test version 1 airborne status, which reports ACAS inverted.
+func testOpStatusAirV1(t *testing.T) {
+	testOpStatus(t, "8dabcdeff812000800382c7b5c19", &adsb.OpStatus{
+		Version:  1,
+		CC:       0x1200,
+		OM:       0x0800,
+		ACAS:     true,
+		CDTI:     true,
+		ARV:      true,
+		ATC:      true,
+		NICSuppA: true,
+		NACp:     8,
+		SIL:      2,
+		BAI:      true,
+		HRD:      true,
+	})
+}
+
+// test version 3 airborne status, which uses the version 2 layout.
+func testOpStatusAirV3(t *testing.T) {
+	testOpStatus(t, "8dabcdeff83340120069b83511f2", &adsb.OpStatus{
+		Version:  3,
+		CC:       0x3340,
+		OM:       0x1200,
+		ACAS:     true,
+		ES1090In: true,
+		ARV:      true,
+		TS:       true,
+		TC:       1,
+		Ident:    true,
+		SDA:      2,
+		NACp:     9,
+		GVA:      2,
+		SIL:      3,
+		BAI:      true,
+	})
+}
+
+// test version 2 surface status.
+func testOpStatusSurfV2(t *testing.T) {
+	testOpStatus(t, "8dabcdeff9315f07425a3abc1c64", &adsb.OpStatus{
+		Subtype:       1,
+		Version:       2,
+		CC:            0x315,
+		OM:            0x0742,
+		ES1090In:      true,
+		UATIn:         true,
+		POA:           true,
+		NACv:          2,
+		SingleAntenna: true,
+		SDA:           3,
+		AntennaOffset: 0x42,
+		NICSuppA:      true,
+		NICSuppC:      true,
+		NACp:          10,
+		SIL:           3,
+		SILSupp:       true,
+		TAH:           true,
+		LW:            15,
+		Length:        85,
+		Width:         90,
+	})
+}
+
+// test version 0 surface status, which decodes only the raw codes.
+func testOpStatusSurfV0(t *testing.T) {
+	testOpStatus(t, "8dabcdeff91231beef0000f68a03", &adsb.OpStatus{
+		Subtype: 1,
+		CC:      0x123,
+		OM:      0xbeef,
+		LW:      1,
+		Length:  15,
+		Width:   23,
+	})
+}
+
+func testOpStatus(t *testing.T, msg string, exp *adsb.OpStatus) {
+	t.Helper()
+
+	m := testMsg(t, msg)
+
+	s, err := m.OpStatus()
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	if *s != *exp {
+		t.Errorf("received %+v, expected %+v", *s, *exp)
+	}
+}
+
+// test an undefined operational status subtype.
+func testOpStatusUnknown(t *testing.T) {
+	m := testMsg(t, "8dabcdeffa0000000000008b43c9")
+
+	s, err := m.OpStatus()
+	if err == nil {
+		t.Fatal("received nil, expected error")
+	}
+
+	if err.Error() != "error retrieving operational status: unknown subtype 2" {
+		t.Error("received unexpected error", err)
+	}
+
+	if s != nil {
+		t.Error("received unexpected data")
+	}
+}
+
+// test a message without operational status.
+func testOpStatusNotAvailable(t *testing.T) {
+	testNotAvailable(t, (*adsb.Message).OpStatus)
+}
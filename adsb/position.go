@@ -24,9 +24,15 @@ package adsb
 
 import (
 	"math"
+
+	"github.com/ccoveille/go-safecast/v2"
 )
 
-// CPR is an extended squitter compact position report.
+// CPR is an extended squitter compact position report. Airborne
+// positions use the 17 bit encoding and surface positions use the 19 bit
+// encoding, of which only the lower 17 bits are transmitted. The 14 and
+// 12 bit airborne encodings are used by intent and coarse format TIS-B
+// messages.
 type CPR struct {
 	Nb  uint8  // number of encoded bits (17, 19, 14 or 12)
 	T   uint8  // time bit
@@ -39,21 +45,22 @@ type CPR struct {
 // longitude by comparing the position to a known reference point.
 // Argument and return value is in the format [latitude, longitude].
 func (c *CPR) DecodeLocal(rp []float64) ([]float64, error) {
-	switch {
-	case len(rp) != 2:
-		return nil, newError(nil, "must provide [lat, lon] as argument")
-	case rp[0] > 90 || rp[0] < -90:
-		return nil, newError(nil, "latitude out of range (-90 to 90)")
-	case rp[1] > 190 || rp[1] < -180:
-		return nil, newError(nil, "longitude out of range (-180 to 180)")
+	err := checkRef(rp)
+	if err != nil {
+		return nil, err
+	}
+
+	span, scale, err := c.params()
+	if err != nil {
+		return nil, err
 	}
 
 	latr := rp[0]
 	lonr := rp[1]
-	latc := float64(c.Lat) / 131072
-	lonc := float64(c.Lon) / 131072
+	latc := float64(c.Lat) / scale
+	lonc := float64(c.Lon) / scale
 
-	dlat := 360.0 / float64(60-c.F)
+	dlat := span / float64(60-c.F)
 
 	j := math.Floor(latr/dlat) +
 		math.Floor((mod(latr, dlat)/dlat)-latc+0.5)
@@ -67,9 +74,9 @@ func (c *CPR) DecodeLocal(rp []float64) ([]float64, error) {
 	nl := float64(cprNL(coord[0]) - c.F)
 
 	if nl == 0 {
-		dlon = 360.0
+		dlon = span
 	} else {
-		dlon = 360.0 / nl
+		dlon = span / nl
 	}
 
 	m := math.Floor(lonr/dlon) +
@@ -80,19 +87,172 @@ func (c *CPR) DecodeLocal(rp []float64) ([]float64, error) {
 	return coord, nil
 }
 
+// LocalPosition decodes an encoded position in the same manner as
+// DecodeLocal, using a Position for the reference point and result.
+func (c *CPR) LocalPosition(rp Position) (*Position, error) {
+	coord, err := c.DecodeLocal(rp.Slice())
+	if err != nil {
+		return nil, err
+	}
+
+	return &Position{Lat: coord[0], Lon: coord[1]}, nil
+}
+
 // DecodeGlobalPosition decodes an encoded position to a globally
 // unabmiguous latitude and longitude by combining two CPR messages.
 // The two messages must have different formats (CPR.F) and must have
 // a time difference of less than 10 seconds (3 NM distance). The
 // return value is in the format [latitude, longitude].
+//
+// Surface positions can not be decoded without a reference point, use
+// DecodeGlobalSurfacePosition instead.
 func DecodeGlobalPosition(c1 *CPR, c2 *CPR) ([]float64, error) {
+	err := checkGlobal(c1, c2)
+	if err != nil {
+		return nil, err
+	}
+
+	if c1.Nb == 19 {
+		return nil, newError(nil, "surface position requires reference point")
+	}
+
+	return decodeGlobal(c1, c2, nil)
+}
+
+// DecodeGlobalSurfacePosition decodes an encoded surface position to a
+// latitude and longitude by combining two CPR messages. The messages
+// must meet the same requirements as DecodeGlobalPosition, with a time
+// difference of less than 25 seconds (0.75 NM distance) when moving.
+// Since a surface position is ambiguous by multiples of 90 degrees,
+// the solution nearest to the reference point is returned. Argument
+// and return value is in the format [latitude, longitude].
+func DecodeGlobalSurfacePosition(c1 *CPR, c2 *CPR, rp []float64) ([]float64, error) {
+	err := checkRef(rp)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkGlobal(c1, c2)
+	if err != nil {
+		return nil, err
+	}
+
+	if c1.Nb != 19 {
+		return nil, newError(nil, "must provide surface positions")
+	}
+
+	return decodeGlobal(c1, c2, rp)
+}
+
+// EncodeCPR encodes a latitude and longitude as a compact position
+// report with the given format (0 for even, 1 for odd) and number of
+// encoded bits. Argument is in the format [latitude, longitude].
+func EncodeCPR(pos []float64, f uint8, nb uint8) (*CPR, error) {
+	err := checkRef(pos)
+	if err != nil {
+		return nil, err
+	}
+
+	if f > 1 {
+		return nil, newError(nil, "format must be 0 or 1")
+	}
+
+	c := &CPR{Nb: nb, F: f}
+
+	span, scale, err := c.params()
+	if err != nil {
+		return nil, err
+	}
+
+	dlat := span / float64(60-f)
+	yz := math.Floor(scale*mod(pos[0], dlat)/dlat + 0.5)
+	rlat := dlat * (yz/scale + math.Floor(pos[0]/dlat))
+
+	dlon := span
+
+	if nl := cprNL(rlat); nl > f {
+		dlon = span / float64(nl-f)
+	}
+
+	xz := math.Floor(scale*mod(pos[1], dlon)/dlon + 0.5)
+
+	c.Lat = safecast.MustConvert[uint32](mod(yz, scale))
+	c.Lon = safecast.MustConvert[uint32](mod(xz, scale))
+
+	return c, nil
+}
+
+// EncodeSurfaceCPR encodes a latitude and longitude as a surface
+// compact position report with the given format (0 for even, 1 for
+// odd). Argument is in the format [latitude, longitude].
+func EncodeSurfaceCPR(pos []float64, f uint8) (*CPR, error) {
+	return EncodeCPR(pos, f, 19)
+}
+
+// GlobalPosition decodes a pair of CPR messages in the same manner as
+// DecodeGlobalPosition, returning a Position.
+func GlobalPosition(c1 *CPR, c2 *CPR) (*Position, error) {
+	coord, err := DecodeGlobalPosition(c1, c2)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Position{Lat: coord[0], Lon: coord[1]}, nil
+}
+
+// GlobalSurfacePosition decodes a pair of surface CPR messages in the
+// same manner as DecodeGlobalSurfacePosition, using a Position for the
+// reference point and result.
+func GlobalSurfacePosition(c1 *CPR, c2 *CPR, rp Position) (*Position, error) {
+	coord, err := DecodeGlobalSurfacePosition(c1, c2, rp.Slice())
+	if err != nil {
+		return nil, err
+	}
+
+	return &Position{Lat: coord[0], Lon: coord[1]}, nil
+}
+
+// EncodePosition encodes a Position in the same manner as EncodeCPR.
+func EncodePosition(p Position, f uint8, nb uint8) (*CPR, error) {
+	return EncodeCPR(p.Slice(), f, nb)
+}
+
+// checkGlobal validates a pair of CPR messages for global decoding.
+func checkGlobal(c1 *CPR, c2 *CPR) error {
 	switch {
 	case c1 == nil || c2 == nil:
-		return nil, newError(nil, "incomplete arguments")
+		return newError(nil, "incomplete arguments")
 	case c1.Nb != c2.Nb:
-		return nil, newError(nil, "bit encoding must be equal")
+		return newError(nil, "bit encoding must be equal")
 	case c1.F == c2.F:
-		return nil, newError(nil, "format must be different")
+		return newError(nil, "format must be different")
+	}
+
+	return nil
+}
+
+// checkRef validates a reference point.
+func checkRef(rp []float64) error {
+	switch {
+	case len(rp) != 2:
+		return newError(nil, "must provide [lat, lon] as argument")
+	case math.IsNaN(rp[0]) || math.IsNaN(rp[1]):
+		return newError(nil, "coordinates must be numbers")
+	case rp[0] > 90 || rp[0] < -90:
+		return newError(nil, "latitude out of range (-90 to 90)")
+	case rp[1] > 180 || rp[1] < -180:
+		return newError(nil, "longitude out of range (-180 to 180)")
+	}
+
+	return nil
+}
+
+// decodeGlobal decodes a pair of CPR messages. If a reference point is
+// provided, the surface position ambiguity is resolved against it.
+func decodeGlobal(c1 *CPR, c2 *CPR, rp []float64) ([]float64, error) {
+	span, scale, err := c1.params()
+	if err != nil {
+		return nil, err
 	}
 
 	var t0 bool // set t0 to true if the even format is the later message
@@ -101,20 +261,20 @@ func DecodeGlobalPosition(c1 *CPR, c2 *CPR) ([]float64, error) {
 
 	if c1.F == 0 {
 		t0 = false
-		lat0 = float64(c1.Lat) / 131072 // 2**17 = 131072
-		lon0 = float64(c1.Lon) / 131072
-		lat1 = float64(c2.Lat) / 131072
-		lon1 = float64(c2.Lon) / 131072
+		lat0 = float64(c1.Lat) / scale
+		lon0 = float64(c1.Lon) / scale
+		lat1 = float64(c2.Lat) / scale
+		lon1 = float64(c2.Lon) / scale
 	} else {
 		t0 = true
-		lat0 = float64(c2.Lat) / 131072
-		lon0 = float64(c2.Lon) / 131072
-		lat1 = float64(c1.Lat) / 131072
-		lon1 = float64(c1.Lon) / 131072
+		lat0 = float64(c2.Lat) / scale
+		lon0 = float64(c2.Lon) / scale
+		lat1 = float64(c1.Lat) / scale
+		lon1 = float64(c1.Lon) / scale
 	}
 
-	dlat0 := 360.0 / 60.0
-	dlat1 := 360.0 / 59.0
+	dlat0 := span / 60.0
+	dlat1 := span / 59.0
 
 	j := math.Floor(((59 * lat0) - (60 * lat1)) + 0.5)
 
@@ -128,16 +288,34 @@ func DecodeGlobalPosition(c1 *CPR, c2 *CPR) ([]float64, error) {
 		rlat1 -= 360
 	}
 
+	// surface latitude is either north or south of the equator
+	if rp != nil && math.Abs(rlat0-90-rp[0]) < math.Abs(rlat0-rp[0]) {
+		rlat0 -= 90
+		rlat1 -= 90
+	}
+
 	if cprNL(rlat0) != cprNL(rlat1) {
 		return nil, newError(nil, "positions cross latitude boundary")
 	}
 
-	coord := calcGlobal(t0, lon0, lon1, rlat0, rlat1)
+	coord := calcGlobal(t0, lon0, lon1, rlat0, rlat1, span)
+
+	// surface longitude is one of four 90 degree quadrants
+	if rp != nil {
+		coord[1] = rp[1] - (mod(rp[1]-coord[1]+45, 90) - 45)
+
+		switch {
+		case coord[1] >= 180:
+			coord[1] -= 360
+		case coord[1] < -180:
+			coord[1] += 360
+		}
+	}
 
 	return coord, nil
 }
 
-func calcGlobal(t0 bool, lon0, lon1, rlat0, rlat1 float64) []float64 {
+func calcGlobal(t0 bool, lon0, lon1, rlat0, rlat1, span float64) []float64 {
 	var nl, ni, dlon, lonc float64
 
 	coord := make([]float64, 2)
@@ -152,7 +330,7 @@ func calcGlobal(t0 bool, lon0, lon1, rlat0, rlat1 float64) []float64 {
 			ni = nl
 		}
 
-		dlon = 360.0 / ni
+		dlon = span / ni
 		lonc = lon0
 	} else {
 		coord[0] = rlat1
@@ -164,7 +342,7 @@ func calcGlobal(t0 bool, lon0, lon1, rlat0, rlat1 float64) []float64 {
 			ni = nl - 1
 		}
 
-		dlon = 360.0 / ni
+		dlon = span / ni
 		lonc = lon1
 	}
 
@@ -178,6 +356,25 @@ func calcGlobal(t0 bool, lon0, lon1, rlat0, rlat1 float64) []float64 {
 	return coord
 }
 
+// params returns the zone span in degrees and the scale of the encoded
+// values for the bit encoding of the CPR. Surface positions are encoded
+// with 19 bits, of which the lower 17 bits are transmitted. Intent and
+// TIS-B positions may use the 14 or 12 bit airborne encodings.
+func (c *CPR) params() (float64, float64, error) {
+	switch c.Nb {
+	case 17:
+		return 360, 131072, nil // 2**17 = 131072
+	case 19:
+		return 90, 131072, nil
+	case 14:
+		return 360, 16384, nil // 2**14 = 16384
+	case 12:
+		return 360, 4096, nil // 2**12 = 4096
+	default:
+		return 0, 0, newErrorf(nil, "bit encoding %d unsupported", c.Nb)
+	}
+}
+
 // mod implements the MOD function as defined in the ADS-B
 // specifications.
 func mod(a float64, b float64) float64 {
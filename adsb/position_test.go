@@ -0,0 +1,328 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb_test
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"kreklow.us/go/go-adsb/adsb"
+)
+
+// TestEncodeCPR runs the test cases for compact position encoding.
+func TestEncodeCPR(t *testing.T) {
+	t.Run("Vectors", testEncodeCPRVectors)
+	t.Run("Messages", testEncodeCPRMessages)
+	t.Run("Boundaries", testEncodeCPRBoundaries)
+	t.Run("RoundTrip", testEncodeCPRRoundTrip)
+	t.Run("SurfaceRoundTrip", testEncodeCPRSurfaceRoundTrip)
+	t.Run("Formats", testEncodeCPRFormats)
+	t.Run("Errors", testEncodeCPRErrors)
+}
+
+// test encoded values at the equator, poles and antimeridian.
+func testEncodeCPRVectors(t *testing.T) {
+	tests := []struct {
+		pos []float64
+		f   uint8
+		exp adsb.CPR
+	}{
+		{[]float64{0, 0}, 0, adsb.CPR{Nb: 17, F: 0, Lat: 0, Lon: 0}},
+		{[]float64{0, 0}, 1, adsb.CPR{Nb: 17, F: 1, Lat: 0, Lon: 0}},
+		{[]float64{3, 3}, 0, adsb.CPR{Nb: 17, F: 0, Lat: 65536, Lon: 64444}},
+		{[]float64{90, 90}, 0, adsb.CPR{Nb: 17, F: 0, Lat: 0, Lon: 32768}},
+		{[]float64{90, 90}, 1, adsb.CPR{Nb: 17, F: 1, Lat: 98304, Lon: 32768}},
+		{[]float64{-90, -90}, 0, adsb.CPR{Nb: 17, F: 0, Lat: 0, Lon: 98304}},
+		{[]float64{0, 180}, 0, adsb.CPR{Nb: 17, F: 0, Lat: 0, Lon: 65536}},
+		{[]float64{0, -180}, 1, adsb.CPR{Nb: 17, F: 1, Lat: 0, Lon: 0}},
+		{[]float64{-3, 0}, 0, adsb.CPR{Nb: 17, F: 0, Lat: 65536, Lon: 0}},
+	}
+
+	for _, tc := range tests {
+		c, err := adsb.EncodeCPR(tc.pos, tc.f, 17)
+		if err != nil {
+			t.Fatal("received unexpected error", err)
+		}
+
+		if *c != tc.exp {
+			t.Errorf("%v: received %+v, expected %+v", tc.pos, *c, tc.exp)
+		}
+	}
+}
+
+// test that decoded message positions encode to the original values.
+func testEncodeCPRMessages(t *testing.T) {
+	testEncodeCPRPair(t, "8d40621d58c386435cc412692ad6", "8d40621d58c382d690c8ac2863a7", false)
+	testEncodeCPRPair(t, "8c4841753a8a35323faebdac702d", "8c4841753aab238733c8cd4020b1", true)
+}
+
+// test positions on either side of longitude zone boundaries and near
+// the poles.
+func testEncodeCPRBoundaries(t *testing.T) {
+	for _, lat := range []float64{
+		10.4704713, 59.95459277, 86.53536998, 87, 89.9999, 90,
+	} {
+		for _, d := range []float64{-0.0001, 0, 0.0001} {
+			for _, lon := range []float64{-179.9999, -45.5, 0, 120.25, 180} {
+				testEncodeCPRLocal(t, []float64{min(lat+d, 90), lon}, 17)
+				testEncodeCPRLocal(t, []float64{-min(lat+d, 90), lon}, 17)
+			}
+		}
+	}
+}
+
+// test random airborne positions for round trip consistency.
+func testEncodeCPRRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2)) //nolint:gosec // deterministic test data
+
+	boundary := 0
+
+	for range 10000 {
+		pos := []float64{r.Float64()*180 - 90, r.Float64()*360 - 180}
+
+		testEncodeCPRLocal(t, pos, 17)
+
+		c0, c1 := testEncodeCPRBoth(t, pos, 17)
+
+		c, err := adsb.DecodeGlobalPosition(c1, c0)
+		if err != nil {
+			boundary++
+
+			continue
+		}
+
+		testEncodeCPRNear(t, pos, c, 17)
+	}
+
+	if boundary > 100 {
+		t.Errorf("received %d latitude boundary errors", boundary)
+	}
+}
+
+// test random surface positions for round trip consistency.
+func testEncodeCPRSurfaceRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4)) //nolint:gosec // deterministic test data
+
+	for range 10000 {
+		pos := []float64{r.Float64()*170 - 85, r.Float64()*360 - 180}
+
+		testEncodeCPRLocal(t, pos, 19)
+
+		c0, c1 := testEncodeCPRBoth(t, pos, 19)
+
+		c, err := adsb.DecodeGlobalSurfacePosition(c1, c0, pos)
+		if err != nil {
+			continue
+		}
+
+		testEncodeCPRNear(t, pos, c, 19)
+	}
+}
+
+// test random positions in the 14 and 12 bit encodings.
+func testEncodeCPRFormats(t *testing.T) {
+	r := rand.New(rand.NewPCG(5, 6)) //nolint:gosec // deterministic test data
+
+	for _, nb := range []uint8{14, 12} {
+		for range 1000 {
+			pos := []float64{r.Float64()*180 - 90, r.Float64()*360 - 180}
+
+			testEncodeCPRLocal(t, pos, nb)
+
+			c0, c1 := testEncodeCPRBoth(t, pos, nb)
+
+			c, err := adsb.DecodeGlobalPosition(c0, c1)
+			if err != nil {
+				continue
+			}
+
+			testEncodeCPRNear(t, pos, c, nb)
+		}
+	}
+
+	c, err := adsb.EncodeCPR([]float64{3, 3}, 0, 12)
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	exp := adsb.CPR{Nb: 12, F: 0, Lat: 2048, Lon: 2014}
+	if *c != exp {
+		t.Errorf("received %+v, expected %+v", *c, exp)
+	}
+}
+
+func testEncodeCPRErrors(t *testing.T) {
+	tests := []struct {
+		pos []float64
+		f   uint8
+		nb  uint8
+		err string
+	}{
+		{[]float64{0}, 0, 17, "must provide [lat, lon] as argument"},
+		{[]float64{math.NaN(), 0}, 0, 17, "coordinates must be numbers"},
+		{[]float64{0, math.NaN()}, 0, 17, "coordinates must be numbers"},
+		{[]float64{91, 0}, 0, 17, "latitude out of range (-90 to 90)"},
+		{[]float64{math.Inf(-1), 0}, 0, 17, "latitude out of range (-90 to 90)"},
+		{[]float64{0, math.Inf(1)}, 0, 17, "longitude out of range (-180 to 180)"},
+		{[]float64{0, 181}, 0, 17, "longitude out of range (-180 to 180)"},
+		{[]float64{0, 0}, 2, 17, "format must be 0 or 1"},
+		{[]float64{0, 0}, 0, 16, "bit encoding 16 unsupported"},
+	}
+
+	for _, tc := range tests {
+		c, err := adsb.EncodeCPR(tc.pos, tc.f, tc.nb)
+		if err == nil || err.Error() != tc.err {
+			t.Errorf("%v: received unexpected error %v", tc.pos, err)
+		}
+
+		if c != nil {
+			t.Error("received unexpected data")
+		}
+	}
+}
+
+// testEncodeCPRPair decodes a pair of messages, the second of which is
+// even, and encodes the decoded position as an even position.
+func testEncodeCPRPair(t *testing.T, odd string, even string, surface bool) {
+	t.Helper()
+
+	c1 := testSurfaceCPR(t, odd)
+	c0 := testSurfaceCPR(t, even)
+
+	var (
+		pos []float64
+		err error
+		c   *adsb.CPR
+	)
+
+	if surface {
+		pos, err = adsb.DecodeGlobalSurfacePosition(c1, c0, []float64{51.990, 4.375})
+		if err == nil {
+			c, err = adsb.EncodeSurfaceCPR(pos, 0)
+		}
+	} else {
+		pos, err = adsb.DecodeGlobalPosition(c1, c0)
+		if err == nil {
+			c, err = adsb.EncodeCPR(pos, 0, 17)
+		}
+	}
+
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	c.T = c0.T
+
+	if *c != *c0 {
+		t.Errorf("received %+v, expected %+v", *c, *c0)
+	}
+}
+
+// testEncodeCPRBoth encodes a position in both formats and verifies
+// that the decoded positions encode to the same values.
+func testEncodeCPRBoth(t *testing.T, pos []float64, nb uint8) (*adsb.CPR, *adsb.CPR) {
+	t.Helper()
+
+	cprs := make([]*adsb.CPR, 2)
+
+	for f := range uint8(2) {
+		c, err := adsb.EncodeCPR(pos, f, nb)
+		if err != nil {
+			t.Fatal("received unexpected error", err)
+		}
+
+		if c.Lat >= 1<<testCPRBits(nb) || c.Lon >= 1<<testCPRBits(nb) {
+			t.Fatalf("%v: encoded value out of range %+v", pos, *c)
+		}
+
+		cprs[f] = c
+	}
+
+	return cprs[0], cprs[1]
+}
+
+// testEncodeCPRLocal encodes a position in both formats and decodes it
+// against itself as the reference point.
+func testEncodeCPRLocal(t *testing.T, pos []float64, nb uint8) {
+	t.Helper()
+
+	c0, c1 := testEncodeCPRBoth(t, pos, nb)
+
+	for _, c := range []*adsb.CPR{c0, c1} {
+		dec, err := c.DecodeLocal(pos)
+		if err != nil {
+			t.Fatal("received unexpected error", err)
+		}
+
+		testEncodeCPRNear(t, pos, dec, nb)
+
+		if dec[1] > 180 {
+			dec[1] -= 360
+		} else if dec[1] < -180 {
+			dec[1] += 360
+		}
+
+		if math.Abs(dec[0]) > 90 {
+			continue
+		}
+
+		c2, err := adsb.EncodeCPR(dec, c.F, nb)
+		if err != nil {
+			t.Fatal("received unexpected error", err)
+		}
+
+		if *c2 != *c {
+			t.Errorf("%v: re-encoded %+v, expected %+v", pos, *c2, *c)
+		}
+	}
+}
+
+// testEncodeCPRNear verifies that a decoded position is within the
+// resolution of the encoding.
+func testEncodeCPRNear(t *testing.T, pos []float64, dec []float64, nb uint8) {
+	t.Helper()
+
+	span := 360.0
+	if nb == 19 {
+		span = 90
+	}
+
+	dlat := math.Abs(dec[0] - pos[0])
+	dlon := math.Abs(math.Mod(dec[1]-pos[1]+540, 360) - 180)
+
+	scale := float64(uint32(1) << testCPRBits(nb))
+
+	if dlat > span/59/scale || dlon > span/scale {
+		t.Errorf("%v: decoded %v", pos, dec)
+	}
+}
+
+// testCPRBits returns the number of transmitted bits of an encoding.
+func testCPRBits(nb uint8) uint8 {
+	if nb == 19 {
+		return 17
+	}
+
+	return nb
+}
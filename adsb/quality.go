@@ -0,0 +1,197 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import "github.com/ccoveille/go-safecast/v2"
+
+// Quality describes the integrity and accuracy of a reported position.
+//
+// Version 0 transponders report a navigation uncertainty category
+// (NUCp), for which Rc holds the horizontal protection limit. Later
+// versions report a navigation integrity category (NIC), for which Rc
+// holds the horizontal containment radius.
+type Quality struct {
+	Version uint8   // ADS-B version used to interpret the type code
+	NUCp    uint8   // navigation uncertainty category (version 0)
+	NIC     uint8   // navigation integrity category (version 1 and later)
+	Rc      float64 // containment radius in meters
+	RcValid bool    // containment radius is known
+
+	NACp      uint8 // navigation accuracy category for position
+	SIL       uint8 // source integrity level
+	NACpValid bool  // NACp and SIL are available
+}
+
+// nucTbl contains the NUCp and horizontal protection limit in meters for
+// each position type code.
+var nucTbl = map[uint64][]float64{
+	5:  {9, 7.5},
+	6:  {8, 25},
+	7:  {7, 185.2},
+	8:  {6, 370.4},
+	9:  {9, 7.5},
+	10: {8, 25},
+	11: {7, 185.2},
+	12: {6, 370.4},
+	13: {5, 926},
+	14: {4, 1852},
+	15: {3, 3704},
+	16: {2, 18520},
+	17: {1, 37040},
+	18: {0, 0},
+	20: {9, 7.5},
+	21: {8, 25},
+	22: {0, 0},
+}
+
+// PositionQuality returns the integrity and accuracy of the position
+// reported by an extended squitter position message.
+//
+// Interpretation of the type code depends on the ADS-B version and the
+// NIC supplements reported in the aircraft operational status. If s is
+// nil the message is interpreted as ADS-B version 0. NACp and SIL are
+// only available when s is provided.
+func (m *Message) PositionQuality(s *OpStatus) (*Quality, error) {
+	tc, err := m.raw.ESType()
+	if err != nil {
+		return nil, newError(err, "error retrieving position quality")
+	}
+
+	nuc, ok := nucTbl[tc]
+	if !ok {
+		return nil, newError(ErrNotAvailable, "error retrieving position quality")
+	}
+
+	q := new(Quality)
+
+	if s == nil || s.Version == 0 {
+		q.NUCp = safecast.MustConvert[uint8](nuc[0])
+		q.Rc = nuc[1]
+		q.RcValid = q.Rc != 0
+
+		return q, nil
+	}
+
+	q.Version = s.Version
+	q.NACp = s.NACp
+	q.SIL = s.SIL
+	q.NACpValid = true
+
+	// NIC supplement B is only defined for airborne positions from
+	// version 2 transponders. TIS-B and ADS-R messages carry the IMF in
+	// the same bit.
+	_, err = m.raw.IMF()
+	b := s.Version >= 2 && tc >= 9 && err != nil && m.raw.esbits(8, 8) == 1
+
+	q.NIC, q.Rc = decodeNIC(tc, s.Version, s.NICSuppA, b, s.NICSuppC)
+	q.RcValid = q.Rc != 0
+
+	return q, nil
+}
+
+// nicTbl contains the NIC and containment radius in meters for each
+// position type code which does not depend on the NIC supplements.
+var nicTbl = map[uint64][]float64{
+	5:  {11, 7.5},
+	6:  {10, 25},
+	9:  {11, 7.5},
+	10: {10, 25},
+	12: {7, 370.4},
+	14: {5, 1852},
+	15: {4, 3704},
+	17: {1, 37040},
+	20: {11, 7.5},
+	21: {10, 25},
+}
+
+// decodeNIC returns the NIC and containment radius in meters for a
+// position type code, ADS-B version and NIC supplements A, B and C.
+func decodeNIC(tc uint64, ver uint8, a, b, c bool) (uint8, float64) {
+	if nic, ok := nicTbl[tc]; ok {
+		return safecast.MustConvert[uint8](nic[0]), nic[1]
+	}
+
+	// version 1 has no supplement B or C, only supplement A applies
+	if ver == 1 {
+		b, c = true, false
+	}
+
+	switch tc {
+	case 7:
+		if a && !c {
+			return 9, 75
+		}
+
+		return 8, 185.2
+	case 8:
+		return decodeSurfaceNIC(ver, a, c)
+	case 11:
+		if a && b {
+			return 9, 75
+		}
+
+		return 8, 185.2
+	case 13:
+		return decodeNIC13(ver, a, b)
+	case 16:
+		if a && b {
+			return 3, 7408
+		}
+
+		return 2, 14816
+	default:
+		return 0, 0
+	}
+}
+
+// decodeSurfaceNIC returns the NIC and containment radius for surface
+// position type code 8.
+func decodeSurfaceNIC(ver uint8, a, c bool) (uint8, float64) {
+	switch {
+	case ver == 1:
+		return 0, 0
+	case a && c:
+		return 7, 370.4
+	case c:
+		return 6, 555.6
+	case a:
+		return 6, 1111.2
+	default:
+		return 0, 0
+	}
+}
+
+// decodeNIC13 returns the NIC and containment radius for airborne
+// position type code 13.
+func decodeNIC13(ver uint8, a, b bool) (uint8, float64) {
+	switch {
+	case ver == 1 && !a:
+		return 6, 926
+	case ver >= 2 && !a && !b:
+		return 6, 926
+	case ver >= 2 && !a && b:
+		return 6, 555.6
+	default:
+		return 6, 1111.2
+	}
+}
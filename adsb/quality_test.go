@@ -0,0 +1,177 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb_test
+
+import (
+	"testing"
+
+	"kreklow.us/go/go-adsb/adsb"
+)
+
+// TestPositionQuality runs the test cases for position quality.
+func TestPositionQuality(t *testing.T) {
+	t.Run("NUCp", testQualityNUCp)
+	t.Run("NUCpUnknown", testQualityNUCpUnknown)
+	t.Run("NICSuppA", testQualityNICSuppA)
+	t.Run("NICSuppB", testQualityNICSuppB)
+	t.Run("TISB", testQualityTISB)
+	t.Run("Version1", testQualityVersion1)
+	t.Run("TypeCode13", testQualityTC13)
+	t.Run("Surface", testQualitySurface)
+	t.Run("NotAvailable", testQualityNotAvailable)
+}
+
+// test version 0 interpretation without operational status.
+func testQualityNUCp(t *testing.T) {
+	testQuality(t, "8d40621d58c382d690c8ac2863a7", nil, &adsb.Quality{
+		NUCp:    7,
+		Rc:      185.2,
+		RcValid: true,
+	})
+}
+
+// test version 0 type code 18 with unknown protection limit.
+func testQualityNUCpUnknown(t *testing.T) {
+	testQuality(t, "8d40621d90c382d690c8ac14b1af", nil, &adsb.Quality{})
+}
+
+// test version 2 with NIC supplement A but not B.
+func testQualityNICSuppA(t *testing.T) {
+	s := &adsb.OpStatus{Version: 2, NICSuppA: true, NACp: 9, SIL: 3}
+
+	testQuality(t, "8d40621d58c382d690c8ac2863a7", s, &adsb.Quality{
+		Version:   2,
+		NIC:       8,
+		Rc:        185.2,
+		RcValid:   true,
+		NACp:      9,
+		SIL:       3,
+		NACpValid: true,
+	})
+}
+
+// test version 2 with NIC supplements A and B.
+func testQualityNICSuppB(t *testing.T) {
+	s := &adsb.OpStatus{Version: 2, NICSuppA: true, NACp: 9, SIL: 3}
+
+	testQuality(t, "8d40621d59c382d690c8acf41950", s, &adsb.Quality{
+		Version:   2,
+		NIC:       9,
+		Rc:        75,
+		RcValid:   true,
+		NACp:      9,
+		SIL:       3,
+		NACpValid: true,
+	})
+}
+
+// test a TIS-B position, where the NIC supplement B bit is the IMF.
+func testQualityTISB(t *testing.T) {
+	s := &adsb.OpStatus{Version: 2, NICSuppA: true, NACp: 9, SIL: 3}
+
+	testQuality(t, "9240621d59c382d690c8ac39f755", s, &adsb.Quality{
+		Version:   2,
+		NIC:       8,
+		Rc:        185.2,
+		RcValid:   true,
+		NACp:      9,
+		SIL:       3,
+		NACpValid: true,
+	})
+}
+
+// test version 1, which has only NIC supplement A.
+func testQualityVersion1(t *testing.T) {
+	s := &adsb.OpStatus{Version: 1, NICSuppA: true, NACp: 8, SIL: 2}
+
+	testQuality(t, "8d40621d58c382d690c8ac2863a7", s, &adsb.Quality{
+		Version:   1,
+		NIC:       9,
+		Rc:        75,
+		RcValid:   true,
+		NACp:      8,
+		SIL:       2,
+		NACpValid: true,
+	})
+}
+
+// test the type code 13 containment radius for each NIC supplement.
+func testQualityTC13(t *testing.T) {
+	tests := []struct {
+		msg string
+		s   *adsb.OpStatus
+		rc  float64
+	}{
+		{"8d40621d68c382d690c8ac6056c2", &adsb.OpStatus{Version: 2}, 926},
+		{"8d40621d69c382d690c8acbc2c35", &adsb.OpStatus{Version: 2}, 555.6},
+		{"8d40621d69c382d690c8acbc2c35", &adsb.OpStatus{Version: 2, NICSuppA: true}, 1111.2},
+		{"8d40621d68c382d690c8ac6056c2", &adsb.OpStatus{Version: 1}, 926},
+		{"8d40621d68c382d690c8ac6056c2", &adsb.OpStatus{Version: 1, NICSuppA: true}, 1111.2},
+	}
+
+	for _, tc := range tests {
+		testQuality(t, tc.msg, tc.s, &adsb.Quality{
+			Version:   tc.s.Version,
+			NIC:       6,
+			Rc:        tc.rc,
+			RcValid:   true,
+			NACpValid: true,
+		})
+	}
+}
+
+// test version 2 surface position with NIC supplement C.
+func testQualitySurface(t *testing.T) {
+	s := &adsb.OpStatus{Subtype: 1, Version: 2, NICSuppC: true, NACp: 10}
+
+	testQuality(t, "8d48417540000000000000a1ad0c", s, &adsb.Quality{
+		Version:   2,
+		NIC:       6,
+		Rc:        555.6,
+		RcValid:   true,
+		NACp:      10,
+		NACpValid: true,
+	})
+}
+
+func testQuality(t *testing.T, msg string, s *adsb.OpStatus, exp *adsb.Quality) {
+	t.Helper()
+
+	m := testMsg(t, msg)
+
+	q, err := m.PositionQuality(s)
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	if *q != *exp {
+		t.Errorf("received %+v, expected %+v", *q, *exp)
+	}
+}
+
+// test a message without a position.
+func testQualityNotAvailable(t *testing.T) {
+	testNotAvailable(t, func(m *adsb.Message) (*adsb.Quality, error) {
+		return m.PositionQuality(nil)
+	})
+}
@@ -24,6 +24,7 @@ package adsb
 
 import (
 	"bytes"
+	"encoding/hex"
 )
 
 // RawMessage is a raw binary ADS-B message with helper methods for
@@ -59,6 +60,39 @@ func (r *RawMessage) UnmarshalBinary(data []byte) error {
 	return nil
 }
 
+// MarshalText implements the TextMarshaler interface, returning the
+// message as a string of hexadecimal digits.
+func (r RawMessage) MarshalText() ([]byte, error) {
+	if r.data.Len() == 0 {
+		return nil, newError(nil, "no data loaded")
+	}
+
+	text := make([]byte, hex.EncodedLen(r.data.Len()))
+	hex.Encode(text, r.data.Bytes())
+
+	return text, nil
+}
+
+// UnmarshalText implements the TextUnmarshaler interface for storing
+// ADS-B data supplied as a string of hexadecimal digits. The message
+// may optionally be framed as "*...;" in the AVR format.
+func (r *RawMessage) UnmarshalText(text []byte) error {
+	text = bytes.TrimSpace(text)
+	text = bytes.TrimPrefix(text, []byte("*"))
+	text = bytes.TrimSuffix(text, []byte(";"))
+
+	data := make([]byte, hex.DecodedLen(len(text)))
+
+	_, err := hex.Decode(data, text)
+	if err != nil {
+		r.data.Reset()
+
+		return newError(err, "invalid hex data")
+	}
+
+	return r.UnmarshalBinary(data)
+}
+
 // AA returns the Address Announced field.
 func (r *RawMessage) AA() (uint64, error) {
 	df, err := r.DF()
@@ -553,3 +587,9 @@ func (r *RawMessage) bytes(n int, z int) []byte {
 
 	return bytes
 }
+
+// fieldBits returns bits n through z of a 56 bit message field such as
+// MB or ME, where the first bit of the field is numbered 1.
+func fieldBits(f uint64, n int, z int) uint64 {
+	return (f >> (56 - z)) & (1<<(z-n+1) - 1)
+}
@@ -59,7 +59,7 @@ func (r *RawMessage) ESAltitude() (uint64, error) {
 	}
 
 	switch tc {
-	case 0, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18:
+	case 0, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 20, 21, 22:
 		return r.esbits(9, 20), nil
 	default:
 		return 0, newErrorf(ErrNotAvailable, "error retrieving %s from %d",
@@ -67,6 +67,55 @@ func (r *RawMessage) ESAltitude() (uint64, error) {
 	}
 }
 
+// ESSubtype returns the extended squitter subtype field.
+func (r *RawMessage) ESSubtype() (uint64, error) {
+	tc, err := r.ESType()
+	if err != nil {
+		return 0, err
+	}
+
+	switch tc {
+	case 19, 23, 24, 28, 31:
+		return r.esbits(6, 8), nil
+	case 29:
+		return r.esbits(6, 7), nil
+	default:
+		return 0, newErrorf(ErrNotAvailable, "error retrieving %s from %d",
+			"ESSubtype", tc)
+	}
+}
+
+// IMF returns the ICAO / Mode A flag of a TIS-B or ADS-R message. A
+// value of 1 indicates that the address field does not contain an ICAO
+// address.
+func (r *RawMessage) IMF() (uint64, error) {
+	cf, err := r.CF()
+	if err != nil {
+		return 0, err
+	}
+
+	switch cf {
+	case 2, 6:
+	case 3:
+		return r.esbits(1, 1), nil
+	default:
+		return 0, newErrorf(ErrNotAvailable, "error retrieving %s from %d/%d",
+			"IMF", 18, cf)
+	}
+
+	switch tc := r.esbits(1, 5); {
+	case tc >= 5 && tc <= 8:
+		return r.esbits(21, 21), nil
+	case tc >= 9 && tc <= 18, tc >= 20 && tc <= 22:
+		return r.esbits(8, 8), nil
+	case tc == 19:
+		return r.esbits(9, 9), nil
+	default:
+		return 0, newErrorf(ErrNotAvailable, "error retrieving %s from %d",
+			"IMF", tc)
+	}
+}
+
 // Get bits from the ME field.
 func (r *RawMessage) esbits(n int, z int) uint64 {
 	return r.Bits(n+32, z+32)
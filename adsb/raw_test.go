@@ -40,6 +40,90 @@ func TestRawUnmarshalInterface(t *testing.T) {
 	}
 }
 
+func TestRawTextInterface(t *testing.T) {
+	var i any = new(adsb.RawMessage)
+	if _, ok := i.(encoding.TextMarshaler); !ok {
+		t.Fatal("RawMessage does not implement encoding.TextMarshaler")
+	}
+
+	if _, ok := i.(encoding.TextUnmarshaler); !ok {
+		t.Fatal("RawMessage does not implement encoding.TextUnmarshaler")
+	}
+}
+
+func TestRawText(t *testing.T) {
+	t.Run("Hex", testRawTextHex)
+	t.Run("AVR", testRawTextAVR)
+	t.Run("Invalid", testRawTextInvalid)
+	t.Run("Length", testRawTextLength)
+	t.Run("NoData", testRawTextNoData)
+}
+
+func testRawTextHex(t *testing.T) {
+	testRawTextRoundTrip(t, "8DA2F111581FB4842D1F59EEA2B7", "8da2f111581fb4842d1f59eea2b7")
+}
+
+func testRawTextAVR(t *testing.T) {
+	testRawTextRoundTrip(t, "*5daa234a912889;\n", "5daa234a912889")
+}
+
+func testRawTextRoundTrip(t *testing.T, in string, out string) {
+	t.Helper()
+
+	rm := new(adsb.RawMessage)
+
+	err := rm.UnmarshalText([]byte(in))
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	b, err := rm.MarshalText()
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	if string(b) != out {
+		t.Errorf("expected %s, received %s", out, b)
+	}
+}
+
+func testRawTextInvalid(t *testing.T) {
+	testRawTextErr(t, "*8da2f111zz;",
+		"invalid hex data: encoding/hex: invalid byte: U+007A 'z'")
+}
+
+func testRawTextLength(t *testing.T) {
+	testRawTextErr(t, "8800ff00", "incorrect data length: 32 bits with format 17")
+}
+
+func testRawTextErr(t *testing.T, m string, e string) {
+	t.Helper()
+
+	rm := new(adsb.RawMessage)
+
+	err := rm.UnmarshalText([]byte(m))
+	if err == nil {
+		t.Fatal("expected error, received nil")
+	} else if err.Error() != e {
+		t.Fatalf("expected %s, received %s", e, err)
+	}
+}
+
+func testRawTextNoData(t *testing.T) {
+	rm := new(adsb.RawMessage)
+
+	b, err := rm.MarshalText()
+	if err == nil {
+		t.Fatal("expected error, received nil")
+	} else if err.Error() != "no data loaded" {
+		t.Fatalf("expected %s, received %s", "no data loaded", err)
+	}
+
+	if b != nil {
+		t.Errorf("expected nil, received %s", b)
+	}
+}
+
 func TestRawUnmarshalErrors(t *testing.T) {
 	t.Run("NoData", testRawUnmarshalNoData)
 	t.Run("Short0", testRawUnmarshalShort0)
@@ -640,6 +724,7 @@ func TestRawFieldsNotLoaded(t *testing.T) {
 		"ND": rm.ND, "PI": rm.PI, "RI": rm.RI,
 		"SL": rm.SL, "UM": rm.UM, "VS": rm.VS,
 		"ESType": rm.ESType, "ESAltitude": rm.ESAltitude,
+		"ESSubtype": rm.ESSubtype, "IMF": rm.IMF,
 	}
 
 	expErr := "no data loaded"
@@ -0,0 +1,128 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"github.com/ccoveille/go-safecast/v2"
+	"kreklow.us/go/go-adsb/adsbtype"
+)
+
+var srcTbl = map[uint64]adsbtype.SRC{
+	0: adsbtype.SRC1,
+	1: adsbtype.SRC1,
+	2: adsbtype.SRC2,
+	3: adsbtype.SRC3,
+	4: adsbtype.SRC4,
+	5: adsbtype.SRC2,
+	6: adsbtype.SRC5,
+}
+
+// Source returns the source type of an extended squitter message.
+func (m *Message) Source() (adsbtype.SRC, error) {
+	df, err := m.raw.DF()
+	if err != nil {
+		return 0, newError(err, "error retrieving source")
+	}
+
+	switch df {
+	case 17:
+		return adsbtype.SRC0, nil
+	case 18:
+		cf, _ := m.raw.CF()
+
+		src, ok := srcTbl[cf]
+		if !ok {
+			return 0, newErrorf(ErrNotAvailable,
+				"error retrieving source from %d/%d", df, cf)
+		}
+
+		return src, nil
+	default:
+		return 0, newError(ErrNotAvailable, "error retrieving source")
+	}
+}
+
+// Rebroadcast reports whether an extended squitter message was relayed
+// by a ground station as TIS-B or ADS-R rather than transmitted by the
+// aircraft itself.
+func (m *Message) Rebroadcast() (bool, error) {
+	src, err := m.Source()
+	if err != nil {
+		return false, newError(err, "error retrieving rebroadcast")
+	}
+
+	return src >= adsbtype.SRC2, nil
+}
+
+// NonICAO reports whether the address returned by ICAO is an anonymous,
+// ground assigned or other non-ICAO address. For TIS-B and ADS-R
+// messages this is determined by the IMF bit, which is not present in
+// every message type.
+func (m *Message) NonICAO() (bool, error) {
+	df, err := m.raw.DF()
+	if err != nil {
+		return false, newError(err, "error retrieving address type")
+	}
+
+	switch df {
+	case 17:
+		return false, nil
+	case 18:
+	default:
+		return false, newError(ErrNotAvailable, "error retrieving address type")
+	}
+
+	switch cf, _ := m.raw.CF(); cf {
+	case 0:
+		return false, nil
+	case 1, 5:
+		return true, nil
+	}
+
+	imf, err := m.raw.IMF()
+	if err != nil {
+		return false, newError(err, "error retrieving address type")
+	}
+
+	return imf == 1, nil
+}
+
+// coarse reports whether the message is a coarse format TIS-B airborne
+// position, which has no type code.
+func (m *Message) coarse() bool {
+	cf, err := m.raw.CF()
+
+	return err == nil && cf == 3
+}
+
+// coarseCPR returns the 12 bit compact position report of a coarse
+// format TIS-B airborne position.
+func (m *Message) coarseCPR() *CPR {
+	c := new(CPR)
+	c.Nb = 12
+	c.F = m.raw.Bit(62)
+	c.Lat = safecast.MustConvert[uint32](m.raw.esbits(31, 42))
+	c.Lon = safecast.MustConvert[uint32](m.raw.esbits(43, 54))
+
+	return c
+}
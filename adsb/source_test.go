@@ -0,0 +1,157 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"kreklow.us/go/go-adsb/adsb"
+	"kreklow.us/go/go-adsb/adsbtype"
+)
+
+// TestSource tests the source type, rebroadcast and address type of
+// extended squitter messages.
+func TestSource(t *testing.T) {
+	for msg, exp := range map[string]struct {
+		src     adsbtype.SRC
+		rebcst  bool
+		nonICAO bool
+	}{
+		"8d40621d58c382d690c8ac2863a7": {adsbtype.SRC0, false, false},
+		"90abcdef58c382d690c8ac398352": {adsbtype.SRC1, false, false},
+		"91abcdef58c382d690c8ac61f22a": {adsbtype.SRC1, false, true},
+		"92abcdef59c382d690c8ac551b55": {adsbtype.SRC2, true, true},
+		"93abcdefae1c50a569448c8027ae": {adsbtype.SRC3, true, true},
+		"96abcdef99000000000000d38dff": {adsbtype.SRC5, true, false},
+	} {
+		m := testMsg(t, msg)
+
+		src, err := m.Source()
+		if err != nil {
+			t.Error("received unexpected error", err)
+		} else if src != exp.src {
+			t.Errorf("%s: received source %s, expected %s", msg, src, exp.src)
+		}
+
+		rebcst, err := m.Rebroadcast()
+		if err != nil {
+			t.Error("received unexpected error", err)
+		} else if rebcst != exp.rebcst {
+			t.Errorf("%s: received rebroadcast %t, expected %t", msg, rebcst, exp.rebcst)
+		}
+
+		nonICAO, err := m.NonICAO()
+		if err != nil {
+			t.Error("received unexpected error", err)
+		} else if nonICAO != exp.nonICAO {
+			t.Errorf("%s: received non-ICAO %t, expected %t", msg, nonICAO, exp.nonICAO)
+		}
+	}
+}
+
+// TestSourceNotAvailable tests messages without a source type or
+// address type.
+func TestSourceNotAvailable(t *testing.T) {
+	t.Run("Source", testSourceNotAvailable)
+	t.Run("NonICAO", testNonICAONotAvailable)
+}
+
+// test messages without a source type.
+func testSourceNotAvailable(t *testing.T) {
+	for _, msg := range []string{
+		"97abcdef00000000000000b30bd7",
+		"20001910bc45e9",
+	} {
+		m := testMsg(t, msg)
+
+		_, err := m.Source()
+		if !errors.Is(err, adsb.ErrNotAvailable) {
+			t.Error("expected ErrNotAvailable, received", err)
+		}
+
+		_, err = m.Rebroadcast()
+		if !errors.Is(err, adsb.ErrNotAvailable) {
+			t.Error("expected ErrNotAvailable, received", err)
+		}
+	}
+}
+
+// test messages without an address type.
+func testNonICAONotAvailable(t *testing.T) {
+	for _, msg := range []string{
+		"94abcdef000000000000005b985f",
+		"92abcdef20000000000000056d00",
+		"20001910bc45e9",
+	} {
+		m := testMsg(t, msg)
+
+		_, err := m.NonICAO()
+		if !errors.Is(err, adsb.ErrNotAvailable) {
+			t.Error("expected ErrNotAvailable, received", err)
+		}
+	}
+}
+
+// TestCoarsePosition tests the coarse format TIS-B airborne position.
+func TestCoarsePosition(t *testing.T) {
+	m := testMsg(t, "93abcdefae1c50a569448c8027ae")
+
+	c, err := m.CPR()
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	exp := adsb.CPR{Nb: 12, F: 1, Lat: 0x5a5, Lon: 0x123}
+	if *c != exp {
+		t.Errorf("received %+v, expected %+v", *c, exp)
+	}
+
+	pos, err := c.DecodeLocal([]float64{40, -100})
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	if math.Abs(pos[0]-38.762745) > 0.000001 || math.Abs(pos[1]+103.431641) > 0.000001 {
+		t.Errorf("received %v, expected [38.762745 -103.431641]", pos)
+	}
+
+	alt, err := m.Alt()
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	if alt != 38000 {
+		t.Errorf("received altitude %d, expected 38000", alt)
+	}
+
+	at, err := m.AltType()
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	if at != adsbtype.ATS0 {
+		t.Errorf("received altitude type %s, expected %s", at, adsbtype.ATS0)
+	}
+}
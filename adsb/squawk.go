@@ -0,0 +1,124 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import "kreklow.us/go/go-adsb/adsbtype"
+
+// Squawk is a Mode A code stored as four octal digits.
+type Squawk []byte
+
+// String returns the code as four digits, for example "7700".
+func (s Squawk) String() string {
+	b := make([]byte, len(s))
+
+	for i, v := range s {
+		b[i] = '0' + v
+	}
+
+	return string(b)
+}
+
+// Int returns the code as a decimal integer with the same digits, for
+// example 7700.
+func (s Squawk) Int() int {
+	var n int
+
+	for _, v := range s {
+		n = n*10 + int(v)
+	}
+
+	return n
+}
+
+// Emergency reports whether the code is one of the emergency codes
+// 7500, 7600 or 7700.
+func (s Squawk) Emergency() bool {
+	return s.EPS() != adsbtype.EPS0
+}
+
+// EPS returns the emergency status indicated by the code: unlawful
+// interference for 7500, no communications for 7600 and general
+// emergency for 7700. Any other code returns EPS0.
+func (s Squawk) EPS() adsbtype.EPS {
+	switch s.Int() {
+	case 7500:
+		return adsbtype.EPS5
+	case 7600:
+		return adsbtype.EPS4
+	case 7700:
+		return adsbtype.EPS1
+	default:
+		return adsbtype.EPS0
+	}
+}
+
+// Special reports whether the code is reserved for a special purpose.
+// In addition to the emergency codes this includes 1000 (Mode S
+// conspicuity), 1200 (VFR in North America), 2000 (no code assigned),
+// 7000 (VFR) and 7400 (unmanned aircraft lost link).
+func (s Squawk) Special() bool {
+	switch s.Int() {
+	case 1000, 1200, 2000, 7000, 7400:
+		return true
+	default:
+		return s.Emergency()
+	}
+}
+
+// FlightStatus is the flight status reported in surveillance and Comm-B
+// replies.
+type FlightStatus struct {
+	FS          adsbtype.FS // flight status field
+	Alert       bool        // Mode A code has changed or is an emergency code
+	SPI         bool        // special position identification is active
+	OnGround    bool        // aircraft is on the ground
+	GroundValid bool        // airborne / on ground status is available
+	Sqk         Squawk      // Mode A code, DF5 and DF21 only
+}
+
+// FlightStatus returns the flight status from a DF4, DF5, DF20 or DF21
+// reply. The Mode A code is included for DF5 and DF21 replies.
+func (m *Message) FlightStatus() (*FlightStatus, error) {
+	fs, err := m.raw.FS()
+	if err != nil {
+		return nil, newError(err, "error retrieving flight status")
+	}
+
+	if fs > 5 {
+		return nil, newErrorf(nil,
+			"error retrieving flight status: unknown value %d", fs)
+	}
+
+	s := new(FlightStatus)
+	s.FS = adsbtype.FS(fs)
+	s.Alert = fs >= 2 && fs <= 4
+	s.SPI = fs == 4 || fs == 5
+	s.OnGround = fs == 1 || fs == 3
+	s.GroundValid = fs <= 3
+
+	if df, _ := m.raw.DF(); df == 5 || df == 21 {
+		s.Sqk = m.modeA(20)
+	}
+
+	return s, nil
+}
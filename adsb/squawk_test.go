@@ -0,0 +1,138 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb_test
+
+import (
+	"errors"
+	"testing"
+
+	"kreklow.us/go/go-adsb/adsb"
+	"kreklow.us/go/go-adsb/adsbtype"
+)
+
+// TestSquawk tests formatting and classification of Mode A codes.
+func TestSquawk(t *testing.T) {
+	for _, tc := range []struct {
+		sqk       adsb.Squawk
+		str       string
+		num       int
+		eps       adsbtype.EPS
+		emergency bool
+		special   bool
+	}{
+		{adsb.Squawk{3, 4, 5, 2}, "3452", 3452, adsbtype.EPS0, false, false},
+		{adsb.Squawk{0, 0, 4, 7}, "0047", 47, adsbtype.EPS0, false, false},
+		{adsb.Squawk{1, 2, 0, 0}, "1200", 1200, adsbtype.EPS0, false, true},
+		{adsb.Squawk{7, 0, 0, 0}, "7000", 7000, adsbtype.EPS0, false, true},
+		{adsb.Squawk{7, 5, 0, 0}, "7500", 7500, adsbtype.EPS5, true, true},
+		{adsb.Squawk{7, 6, 0, 0}, "7600", 7600, adsbtype.EPS4, true, true},
+		{adsb.Squawk{7, 7, 0, 0}, "7700", 7700, adsbtype.EPS1, true, true},
+	} {
+		if tc.sqk.String() != tc.str {
+			t.Errorf("String: received %s, expected %s", tc.sqk, tc.str)
+		}
+
+		if tc.sqk.Int() != tc.num {
+			t.Errorf("Int: received %d, expected %d", tc.sqk.Int(), tc.num)
+		}
+
+		if tc.sqk.EPS() != tc.eps {
+			t.Errorf("%s EPS: received %s, expected %s", tc.sqk, tc.sqk.EPS(), tc.eps)
+		}
+
+		if tc.sqk.Emergency() != tc.emergency {
+			t.Errorf("%s Emergency: received %t", tc.sqk, tc.sqk.Emergency())
+		}
+
+		if tc.sqk.Special() != tc.special {
+			t.Errorf("%s Special: received %t", tc.sqk, tc.sqk.Special())
+		}
+	}
+}
+
+// TestFlightStatus runs the test cases for flight status decoding.
+func TestFlightStatus(t *testing.T) {
+	t.Run("Status", testFlightStatus)
+	t.Run("Unknown", testFlightStatusUnknown)
+	t.Run("NotAvailable", testFlightStatusNotAvailable)
+}
+
+// test flight status with and without a Mode A code.
+func testFlightStatus(t *testing.T) {
+	for msg, exp := range map[string]adsb.FlightStatus{
+		"28001b0601970d": {
+			FS: adsbtype.FS0, GroundValid: true, Sqk: adsb.Squawk{3, 4, 5, 2},
+		},
+		"ac19b29573482f6963663636022b": {
+			FS: adsbtype.FS4, Alert: true, SPI: true, Sqk: adsb.Squawk{6, 0, 1, 7},
+		},
+		"210019109e0fbb": {
+			FS: adsbtype.FS1, OnGround: true, GroundValid: true,
+		},
+	} {
+		m := testMsg(t, msg)
+
+		s, err := m.FlightStatus()
+		if err != nil {
+			t.Fatal("received unexpected error", err)
+		}
+
+		if s.FS != exp.FS || s.Alert != exp.Alert || s.SPI != exp.SPI ||
+			s.OnGround != exp.OnGround || s.GroundValid != exp.GroundValid ||
+			s.Sqk.String() != exp.Sqk.String() {
+			t.Errorf("%s: received %+v, expected %+v", msg, *s, exp)
+		}
+	}
+}
+
+// test an undefined flight status.
+func testFlightStatusUnknown(t *testing.T) {
+	m := testMsg(t, "27001910660051")
+
+	s, err := m.FlightStatus()
+	if err == nil {
+		t.Fatal("received nil, expected error")
+	}
+
+	if err.Error() != "error retrieving flight status: unknown value 7" {
+		t.Error("received unexpected error", err)
+	}
+
+	if s != nil {
+		t.Error("received unexpected data")
+	}
+}
+
+// test a message without flight status.
+func testFlightStatusNotAvailable(t *testing.T) {
+	m := testMsg(t, "8dacf84e23101332cf3ca037ef13")
+
+	s, err := m.FlightStatus()
+	if !errors.Is(err, adsb.ErrNotAvailable) {
+		t.Error("expected ErrNotAvailable, received", err)
+	}
+
+	if s != nil {
+		t.Error("received unexpected data")
+	}
+}
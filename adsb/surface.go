@@ -0,0 +1,84 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import "github.com/ccoveille/go-safecast/v2"
+
+// Movement is an extended squitter surface movement report. Values
+// which are not present in the message are indicated by the
+// corresponding Valid field being false.
+type Movement struct {
+	Code        uint8   // encoded movement value
+	GroundSpeed float64 // ground speed in knots
+	GSValid     bool    // ground speed is available
+	Track       float64 // ground track in degrees
+	TrkValid    bool    // ground track is available
+}
+
+// movTbl contains the movement quantisation as defined in the ADS-B
+// specifications. Each entry contains the first code, the ground speed
+// of the first code and the ground speed increment per code.
+var movTbl = [][]float64{
+	{124, 175, 0},
+	{109, 100, 5},
+	{94, 70, 2},
+	{39, 15, 1},
+	{13, 2, 0.5},
+	{9, 1, 0.25},
+	{2, 0.125, 0.125},
+	{1, 0, 0},
+}
+
+// Movement returns the surface movement report.
+//
+// A movement code of 124 indicates a ground speed of 175 knots or
+// greater, and is reported as 175 knots.
+func (m *Message) Movement() (*Movement, error) {
+	tc, err := m.raw.ESType()
+	if err != nil {
+		return nil, newError(err, "error retrieving movement")
+	}
+
+	if tc < 5 || tc > 8 {
+		return nil, newError(ErrNotAvailable, "error retrieving movement")
+	}
+
+	mv := new(Movement)
+	mv.Code = safecast.MustConvert[uint8](m.raw.esbits(6, 12))
+
+	for _, v := range movTbl {
+		if mv.Code < 125 && float64(mv.Code) >= v[0] {
+			mv.GroundSpeed = v[1] + (float64(mv.Code)-v[0])*v[2]
+			mv.GSValid = true
+
+			break
+		}
+	}
+
+	if m.raw.esbits(13, 13) == 1 {
+		mv.Track = float64(m.raw.esbits(14, 20)) * 360 / 128
+		mv.TrkValid = true
+	}
+
+	return mv, nil
+}
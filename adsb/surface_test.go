@@ -0,0 +1,195 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"kreklow.us/go/go-adsb/adsb"
+)
+
+// TestSurface runs the test cases for surface position decoding.
+func TestSurface(t *testing.T) {
+	t.Run("Global", testSurfaceGlobal)
+	t.Run("GlobalReverse", testSurfaceGlobalRev)
+	t.Run("GlobalSouth", testSurfaceGlobalSouth)
+	t.Run("Local", testSurfaceLocal)
+	t.Run("Movement", testSurfaceMovement)
+	t.Run("MovementCodes", testSurfaceMovementCodes)
+	t.Run("Errors", testSurfaceErrors)
+}
+
+func testSurfaceGlobal(t *testing.T) {
+	c1 := testSurfaceCPR(t, "8c4841753aab238733c8cd4020b1")
+	c2 := testSurfaceCPR(t, "8c4841753a8a35323faebdac702d")
+
+	c, err := adsb.DecodeGlobalSurfacePosition(c1, c2, []float64{51.990, 4.375})
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	testSurfacePos(t, c, 52.32061, 4.73473)
+}
+
+func testSurfaceGlobalRev(t *testing.T) {
+	c1 := testSurfaceCPR(t, "8c4841753a8a35323faebdac702d")
+	c2 := testSurfaceCPR(t, "8c4841753aab238733c8cd4020b1")
+
+	c, err := adsb.DecodeGlobalSurfacePosition(c1, c2, []float64{51.990, 4.375})
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	testSurfacePos(t, c, 52.32304, 4.73047)
+}
+
+// test the same messages resolved against a southern reference point.
+func testSurfaceGlobalSouth(t *testing.T) {
+	c1 := testSurfaceCPR(t, "8c4841753aab238733c8cd4020b1")
+	c2 := testSurfaceCPR(t, "8c4841753a8a35323faebdac702d")
+
+	c, err := adsb.DecodeGlobalSurfacePosition(c1, c2, []float64{-30, -100})
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	testSurfacePos(t, c, -37.67939, -84.44096)
+}
+
+func testSurfaceLocal(t *testing.T) {
+	cpr := testSurfaceCPR(t, "8c4841753a9a153237aef0f275be")
+
+	c, err := cpr.DecodeLocal([]float64{51.990, 4.375})
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	testSurfacePos(t, c, 52.32056, 4.73574)
+}
+
+func testSurfaceMovement(t *testing.T) {
+	m := testMsg(t, "8c4841753a9a153237aef0f275be")
+
+	mv, err := m.Movement()
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	exp := adsb.Movement{
+		Code:        41,
+		GroundSpeed: 17,
+		GSValid:     true,
+		Track:       92.8125,
+		TrkValid:    true,
+	}
+
+	if *mv != exp {
+		t.Errorf("received %+v, expected %+v", *mv, exp)
+	}
+}
+
+// test the movement quantisation boundaries, with the track status
+// bit cleared.
+func testSurfaceMovementCodes(t *testing.T) {
+	for msg, exp := range map[string]adsb.Movement{
+		"8c48417538000000000000000000": {Code: 0},
+		"8c48417538100000000000000000": {Code: 1, GroundSpeed: 0, GSValid: true},
+		"8c48417538200000000000000000": {Code: 2, GroundSpeed: 0.125, GSValid: true},
+		"8c48417538900000000000000000": {Code: 9, GroundSpeed: 1, GSValid: true},
+		"8c48417538d00000000000000000": {Code: 13, GroundSpeed: 2, GSValid: true},
+		"8c4841753de00000000000000000": {Code: 94, GroundSpeed: 70, GSValid: true},
+		"8c4841753fb00000000000000000": {Code: 123, GroundSpeed: 170, GSValid: true},
+		"8c4841753fc00000000000000000": {Code: 124, GroundSpeed: 175, GSValid: true},
+		"8c4841753fd00000000000000000": {Code: 125},
+	} {
+		m := testMsg(t, msg)
+
+		mv, err := m.Movement()
+		if err != nil {
+			t.Fatal("received unexpected error", err)
+		}
+
+		if *mv != exp {
+			t.Errorf("received %+v, expected %+v", *mv, exp)
+		}
+	}
+}
+
+func testSurfaceErrors(t *testing.T) {
+	surf := testSurfaceCPR(t, "8c4841753aab238733c8cd4020b1")
+	surf2 := testSurfaceCPR(t, "8c4841753a8a35323faebdac702d")
+	air := testSurfaceCPR(t, "8da8028758ab0028de078689d437")
+	air2 := testSurfaceCPR(t, "8da8028758ab07b0b8876e81eb25")
+
+	_, err := adsb.DecodeGlobalPosition(surf, surf2)
+	if err == nil || err.Error() != "surface position requires reference point" {
+		t.Error("received unexpected error", err)
+	}
+
+	_, err = adsb.DecodeGlobalSurfacePosition(air, air2, []float64{0, 0})
+	if err == nil || err.Error() != "must provide surface positions" {
+		t.Error("received unexpected error", err)
+	}
+
+	_, err = adsb.DecodeGlobalSurfacePosition(surf, surf2, []float64{0, 181})
+	if err == nil || err.Error() != "longitude out of range (-180 to 180)" {
+		t.Error("received unexpected error", err)
+	}
+
+	_, err = adsb.DecodeGlobalSurfacePosition(surf, surf, []float64{0, 0})
+	if err == nil || err.Error() != "format must be different" {
+		t.Error("received unexpected error", err)
+	}
+
+	_, err = testMsg(t, "8da8028758ab0028de078689d437").Movement()
+	if !errors.Is(err, adsb.ErrNotAvailable) {
+		t.Error("expected ErrNotAvailable, received", err)
+	}
+}
+
+func testSurfaceCPR(t *testing.T, msg string) *adsb.CPR {
+	t.Helper()
+
+	m := testMsg(t, msg)
+
+	cpr, err := m.CPR()
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	return cpr
+}
+
+func testSurfacePos(t *testing.T, c []float64, lat float64, lon float64) {
+	t.Helper()
+
+	if math.Abs(c[0]-lat) > 0.00001 {
+		t.Errorf("Lat: received %f, expected %f", c[0], lat)
+	}
+
+	if math.Abs(c[1]-lon) > 0.00001 {
+		t.Errorf("Lon: received %f, expected %f", c[1], lon)
+	}
+}
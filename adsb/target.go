@@ -0,0 +1,114 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import "github.com/ccoveille/go-safecast/v2"
+
+// TargetState is an extended squitter target state and status report.
+// Values which are not present in the message are indicated by the
+// corresponding Valid field being false.
+//
+// Subtype 0 messages use the DO-260A layout, of which only NACp,
+// NICbaro and SIL are decoded.
+type TargetState struct {
+	Subtype uint8 // target state subtype (0 or 1)
+	SILSupp bool  // SIL is per sample rather than per hour
+
+	AltFMS      bool    // selected altitude is from the FMS rather than MCP/FCU
+	SelAlt      int64   // selected altitude in feet
+	SelAltValid bool    // selected altitude is available
+	Baro        float64 // barometric pressure setting in millibars
+	BaroValid   bool    // barometric pressure setting is available
+	SelHdg      float64 // selected heading in degrees
+	SelHdgValid bool    // selected heading is available
+
+	NACp    uint8 // navigation accuracy category for position
+	NICbaro bool  // barometric altitude is cross-checked
+	SIL     uint8 // source integrity level
+
+	ModeValid bool // autopilot mode flags are available
+	Autopilot bool // autopilot engaged
+	VNAV      bool // vertical navigation mode engaged
+	AltHold   bool // altitude hold mode engaged
+	Approach  bool // approach mode engaged
+	LNAV      bool // lateral navigation mode engaged
+	TCAS      bool // ACAS operational
+}
+
+// TargetState returns the target state and status report.
+func (m *Message) TargetState() (*TargetState, error) {
+	tc, err := m.raw.ESType()
+	if err != nil {
+		return nil, newError(err, "error retrieving target state")
+	}
+
+	if tc != 29 {
+		return nil, newError(ErrNotAvailable, "error retrieving target state")
+	}
+
+	st, _ := m.raw.ESSubtype()
+	if st > 1 {
+		return nil, newErrorf(nil, "error retrieving target state: unknown subtype %d", st)
+	}
+
+	ts := new(TargetState)
+	ts.Subtype = safecast.MustConvert[uint8](st)
+	ts.NACp = safecast.MustConvert[uint8](m.raw.esbits(40, 43))
+	ts.NICbaro = m.raw.esbits(44, 44) == 1
+	ts.SIL = safecast.MustConvert[uint8](m.raw.esbits(45, 46))
+
+	if st == 0 {
+		return ts, nil
+	}
+
+	ts.SILSupp = m.raw.esbits(8, 8) == 1
+	ts.AltFMS = m.raw.esbits(9, 9) == 1
+
+	if alt := m.raw.esbits(10, 20); alt != 0 {
+		ts.SelAlt = (safecast.MustConvert[int64](alt) - 1) * 32
+		ts.SelAltValid = true
+	}
+
+	if baro := m.raw.esbits(21, 29); baro != 0 {
+		ts.Baro = 800 + float64(baro-1)*0.8
+		ts.BaroValid = true
+	}
+
+	if m.raw.esbits(30, 30) == 1 {
+		ts.SelHdg = float64(m.raw.esbits(31, 39)) * 360 / 512
+		ts.SelHdgValid = true
+	}
+
+	if m.raw.esbits(47, 47) == 1 {
+		ts.ModeValid = true
+		ts.Autopilot = m.raw.esbits(48, 48) == 1
+		ts.VNAV = m.raw.esbits(49, 49) == 1
+		ts.AltHold = m.raw.esbits(50, 50) == 1
+		ts.Approach = m.raw.esbits(52, 52) == 1
+		ts.LNAV = m.raw.esbits(54, 54) == 1
+	}
+
+	ts.TCAS = m.raw.esbits(53, 53) == 1
+
+	return ts, nil
+}
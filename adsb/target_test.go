@@ -0,0 +1,125 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"kreklow.us/go/go-adsb/adsb"
+)
+
+// TestTargetState runs the test cases for target state decoding.
+func TestTargetState(t *testing.T) {
+	t.Run("Subtype1", testTargetState1)
+	t.Run("Subtype1NoData", testTargetState1NoData)
+	t.Run("Subtype0", testTargetState0)
+	t.Run("Unknown", testTargetStateUnknown)
+	t.Run("NotAvailable", testTargetStateNotAvailable)
+}
+
+func testTargetState1(t *testing.T) {
+	testTargetState(t, "8da05629ea21485cbf3f8cadaeeb", &adsb.TargetState{
+		Subtype:     1,
+		SelAlt:      16992,
+		SelAltValid: true,
+		Baro:        1012.8,
+		BaroValid:   true,
+		SelHdg:      66.8,
+		SelHdgValid: true,
+		NACp:        9,
+		NICbaro:     true,
+		SIL:         3,
+		ModeValid:   true,
+		Autopilot:   true,
+		VNAV:        true,
+		LNAV:        true,
+		TCAS:        true,
+	})
+}
+
+// test FMS selected altitude without data and invalid mode flags.
+func testTargetState1NoData(t *testing.T) {
+	testTargetState(t, "8d4ca123eb800000014df490fc96", &adsb.TargetState{
+		Subtype: 1,
+		SILSupp: true,
+		AltFMS:  true,
+		NACp:    10,
+		SIL:     3,
+	})
+}
+
+// test the DO-260A layout.
+func testTargetState0(t *testing.T) {
+	testTargetState(t, "8d4ca123e9bd5b7ddf18000d3f3e", &adsb.TargetState{
+		Subtype: 0,
+		NACp:    8,
+		NICbaro: true,
+		SIL:     2,
+	})
+}
+
+func testTargetState(t *testing.T, msg string, exp *adsb.TargetState) {
+	t.Helper()
+
+	m := testMsg(t, msg)
+
+	ts, err := m.TargetState()
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	ts.Baro = math.Round(ts.Baro*10) / 10
+	ts.SelHdg = math.Round(ts.SelHdg*10) / 10
+
+	if *ts != *exp {
+		t.Errorf("received %+v, expected %+v", *ts, *exp)
+	}
+}
+
+func testTargetStateUnknown(t *testing.T) {
+	m := testMsg(t, "8d4ca123ec00000000000026d41a")
+
+	ts, err := m.TargetState()
+	if err == nil {
+		t.Fatal("received nil, expected error")
+	}
+
+	if err.Error() != "error retrieving target state: unknown subtype 2" {
+		t.Error("received unexpected error", err)
+	}
+
+	if ts != nil {
+		t.Error("received unexpected data")
+	}
+}
+
+func testTargetStateNotAvailable(t *testing.T) {
+	m := testMsg(t, "8dacf84e23101332cf3ca037ef13")
+
+	_, err := m.TargetState()
+	if !errors.Is(err, adsb.ErrNotAvailable) {
+		t.Error("expected ErrNotAvailable, received", err)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"math"
+
+	"github.com/ccoveille/go-safecast/v2"
+)
+
+// Velocity is an extended squitter airborne velocity report. Subtypes
+// 1 and 2 report ground speed and track, subtypes 3 and 4 report
+// airspeed and heading. Values which are not present in the message
+// are indicated by the corresponding Valid field being false.
+type Velocity struct {
+	Subtype      uint8 // velocity subtype (1-4)
+	Supersonic   bool  // speeds are encoded with 4 kt resolution
+	IntentChange bool  // intent change flag
+	NACv         uint8 // navigation accuracy category for velocity
+
+	EW          int64   // east-west velocity in knots, positive east
+	NS          int64   // north-south velocity in knots, positive north
+	GroundSpeed float64 // ground speed in knots
+	Track       float64 // ground track in degrees
+	GSValid     bool    // ground speed and track are available
+
+	Heading   float64 // heading in degrees
+	HdgValid  bool    // heading is available
+	Airspeed  int64   // airspeed in knots
+	TAS       bool    // airspeed is true airspeed rather than indicated
+	ASValid   bool    // airspeed is available
+	VRate     int64   // vertical rate in feet per minute, positive up
+	VRateBaro bool    // vertical rate is barometric rather than GNSS
+	VRValid   bool    // vertical rate is available
+
+	AltDiff      int64 // GNSS altitude minus barometric altitude in feet
+	AltDiffValid bool  // altitude difference is available
+}
+
+// Velocity returns the airborne velocity report.
+func (m *Message) Velocity() (*Velocity, error) {
+	tc, err := m.raw.ESType()
+	if err != nil {
+		return nil, newError(err, "error retrieving velocity")
+	}
+
+	if tc != 19 {
+		return nil, newError(ErrNotAvailable, "error retrieving velocity")
+	}
+
+	st, err := m.raw.ESSubtype()
+	if err != nil {
+		return nil, newError(err, "error retrieving velocity")
+	}
+
+	if st < 1 || st > 4 {
+		return nil, newErrorf(nil, "error retrieving velocity: unknown subtype %d", st)
+	}
+
+	v := new(Velocity)
+	v.Subtype = safecast.MustConvert[uint8](st)
+	v.Supersonic = st == 2 || st == 4
+	v.IntentChange = m.raw.esbits(9, 9) == 1
+	v.NACv = safecast.MustConvert[uint8](m.raw.esbits(11, 13))
+
+	var scale int64 = 1
+	if v.Supersonic {
+		scale = 4
+	}
+
+	if st <= 2 {
+		m.decodeGroundVelocity(v, scale)
+	} else {
+		m.decodeAirVelocity(v, scale)
+	}
+
+	if vr := m.raw.esbits(38, 46); vr != 0 {
+		v.VRate = (safecast.MustConvert[int64](vr) - 1) * 64
+		if m.raw.esbits(37, 37) == 1 {
+			v.VRate = -v.VRate
+		}
+
+		v.VRateBaro = m.raw.esbits(36, 36) == 1
+		v.VRValid = true
+	}
+
+	if d := m.raw.esbits(50, 56); d != 0 {
+		v.AltDiff = (safecast.MustConvert[int64](d) - 1) * 25
+		if m.raw.esbits(49, 49) == 1 {
+			v.AltDiff = -v.AltDiff
+		}
+
+		v.AltDiffValid = true
+	}
+
+	return v, nil
+}
+
+// decodeGroundVelocity decodes the east-west and north-south velocity
+// components of subtypes 1 and 2.
+func (m *Message) decodeGroundVelocity(v *Velocity, scale int64) {
+	ew := m.raw.esbits(15, 24)
+	ns := m.raw.esbits(26, 35)
+
+	if ew == 0 || ns == 0 {
+		return
+	}
+
+	v.EW = (safecast.MustConvert[int64](ew) - 1) * scale
+	if m.raw.esbits(14, 14) == 1 {
+		v.EW = -v.EW
+	}
+
+	v.NS = (safecast.MustConvert[int64](ns) - 1) * scale
+	if m.raw.esbits(25, 25) == 1 {
+		v.NS = -v.NS
+	}
+
+	v.GroundSpeed = math.Hypot(float64(v.EW), float64(v.NS))
+	v.Track = mod(math.Atan2(float64(v.EW), float64(v.NS))*180/math.Pi, 360)
+	v.GSValid = true
+}
+
+// decodeAirVelocity decodes the heading and airspeed of subtypes 3
+// and 4.
+func (m *Message) decodeAirVelocity(v *Velocity, scale int64) {
+	if m.raw.esbits(14, 14) == 1 {
+		v.Heading = float64(m.raw.esbits(15, 24)) * 360 / 1024
+		v.HdgValid = true
+	}
+
+	if as := m.raw.esbits(26, 35); as != 0 {
+		v.Airspeed = (safecast.MustConvert[int64](as) - 1) * scale
+		v.TAS = m.raw.esbits(25, 25) == 1
+		v.ASValid = true
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb_test
+
+import (
+	"math"
+	"testing"
+
+	"kreklow.us/go/go-adsb/adsb"
+)
+
+// TestVelocity runs the test cases for airborne velocity decoding.
+func TestVelocity(t *testing.T) {
+	t.Run("Ground", testVelocityGround)
+	t.Run("GroundSupersonic", testVelocityGroundSuper)
+	t.Run("Air", testVelocityAir)
+	t.Run("AirSupersonic", testVelocityAirSuper)
+	t.Run("Unknown", testVelocityUnknown)
+	t.Run("NotAvailable", testVelocityNotAvailable)
+}
+
+// test subtype 1 ground speed.
+func testVelocityGround(t *testing.T) {
+	testVelocity(t, "8d485020994409940838175b284f", &adsb.Velocity{
+		Subtype:      1,
+		EW:           -8,
+		NS:           -159,
+		GroundSpeed:  159.2,
+		Track:        182.88,
+		GSValid:      true,
+		VRate:        -832,
+		VRValid:      true,
+		AltDiff:      550,
+		AltDiffValid: true,
+	})
+}
+
+// test subtype 2 supersonic ground speed.
+func testVelocityGroundSuper(t *testing.T) {
+	testVelocity(t, "8dabcdef9a952d32302c85f1ee07", &adsb.Velocity{
+		Subtype:      2,
+		Supersonic:   true,
+		IntentChange: true,
+		NACv:         2,
+		EW:           -1200,
+		NS:           1600,
+		GroundSpeed:  2000,
+		Track:        323.13,
+		GSValid:      true,
+		VRate:        640,
+		VRateBaro:    true,
+		VRValid:      true,
+		AltDiff:      -100,
+		AltDiffValid: true,
+	})
+}
+
+// test subtype 3 true airspeed and heading.
+func testVelocityAir(t *testing.T) {
+	testVelocity(t, "8da05f219b06b6af189400cbc33f", &adsb.Velocity{
+		Subtype:   3,
+		Heading:   243.98,
+		HdgValid:  true,
+		Airspeed:  375,
+		TAS:       true,
+		ASValid:   true,
+		VRate:     -2304,
+		VRateBaro: true,
+		VRValid:   true,
+	})
+}
+
+// test subtype 4 supersonic indicated airspeed without heading.
+func testVelocityAirSuper(t *testing.T) {
+	testVelocity(t, "8dabcdef9c08001f600000876ae6", &adsb.Velocity{
+		Subtype:    4,
+		Supersonic: true,
+		NACv:       1,
+		Airspeed:   1000,
+		ASValid:    true,
+	})
+}
+
+func testVelocity(t *testing.T, msg string, exp *adsb.Velocity) {
+	t.Helper()
+
+	m := testMsg(t, msg)
+
+	v, err := m.Velocity()
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	v.GroundSpeed = math.Round(v.GroundSpeed*100) / 100
+	v.Track = math.Round(v.Track*100) / 100
+	v.Heading = math.Round(v.Heading*100) / 100
+
+	if *v != *exp {
+		t.Errorf("received %+v, expected %+v", *v, *exp)
+	}
+}
+
+// test an undefined velocity subtype.
+func testVelocityUnknown(t *testing.T) {
+	m := testMsg(t, "8dabcdef9d0000000000000e51dd")
+
+	v, err := m.Velocity()
+	if err == nil {
+		t.Fatal("received nil, expected error")
+	}
+
+	if err.Error() != "error retrieving velocity: unknown subtype 5" {
+		t.Error("received unexpected error", err)
+	}
+
+	if v != nil {
+		t.Error("received unexpected data")
+	}
+}
+
+// test a message without velocity.
+func testVelocityNotAvailable(t *testing.T) {
+	testNotAvailable(t, (*adsb.Message).Velocity)
+}
@@ -32,22 +32,30 @@ import (
 // TestConst tests string formatting of constant values.
 func TestConst(t *testing.T) {
 	for val, out := range map[any]string{
-		adsbtype.CA0: "adsbtype.CA: Level 1",
-		adsbtype.CC0: "adsbtype.CC: Not supported",
-		adsbtype.CF0: "adsbtype.CF: ADS-B message, non-transponder device with ICAO address",
-		adsbtype.DF0: "adsbtype.DF: Short air-air surveillance (ACAS)",
-		adsbtype.DR0: "adsbtype.DR: No request",
-		adsbtype.FS0: "adsbtype.FS: No alert, no SPI, airborne",
-		adsbtype.RI0: "adsbtype.RI: No ACAS",
-		adsbtype.SL0: "adsbtype.SL: ACAS inoperative",
-		adsbtype.VS0: "adsbtype.VS: Airborne",
+		adsbtype.AltEnc1: "adsbtype.AltEnc: 100 ft increments, Gillham code",
+		adsbtype.CA0:     "adsbtype.CA: Level 1",
+		adsbtype.CC0:     "adsbtype.CC: Not supported",
+		adsbtype.CF0:     "adsbtype.CF: ADS-B message, non-transponder device with ICAO address",
+		adsbtype.DF0:     "adsbtype.DF: Short air-air surveillance (ACAS)",
+		adsbtype.DR0:     "adsbtype.DR: No request",
+		adsbtype.FS0:     "adsbtype.FS: No alert, no SPI, airborne",
+		adsbtype.RI0:     "adsbtype.RI: No ACAS",
+		adsbtype.SL0:     "adsbtype.SL: ACAS inoperative",
+		adsbtype.VS0:     "adsbtype.VS: Airborne",
 
-		adsbtype.ATS0:  "adsbtype.ATS: Barometric altitude",
-		adsbtype.BDS02: "adsbtype.BDS: Linked Comm-B, segment 2",
-		adsbtype.SSS0:  "adsbtype.SSS: No condition information",
-		adsbtype.TRS0:  "adsbtype.TRS: No capability",
+		adsbtype.ATS0:    "adsbtype.ATS: Barometric altitude",
+		adsbtype.AltSrc2: "adsbtype.AltSrc: FCU / MCP selected altitude",
+		adsbtype.BDS02:   "adsbtype.BDS: Linked Comm-B, segment 2",
+		adsbtype.FOM2:    "adsbtype.FOM: GNSS",
+		adsbtype.HZD3:    "adsbtype.HZD: Severe",
+		adsbtype.SSS0:    "adsbtype.SSS: No condition information",
+		adsbtype.TRS0:    "adsbtype.TRS: No capability",
 
 		adsbtype.TYPE0: "adsbtype.TYPE: No position information",
+		adsbtype.A3:    "adsbtype.AcCat: Large (75000 to 300000 lbs)",
+		adsbtype.EPS5:  "adsbtype.EPS: Unlawful interference",
+		adsbtype.WTCH:  "adsbtype.WTC: Heavy (> 136000 kg)",
+		adsbtype.SRC5:  "adsbtype.SRC: ADS-R rebroadcast message",
 	} {
 		result := fmt.Sprintf("%T: %s", val, val)
 		if result != out {
@@ -58,22 +66,29 @@ func TestConst(t *testing.T) {
 
 func TestConstUnknown(t *testing.T) {
 	for val, out := range map[any]string{
-		adsbtype.CA(99): "adsbtype.CA: Unknown value 99",
-		adsbtype.CC(99): "adsbtype.CC: Unknown value 99",
-		adsbtype.CF(99): "adsbtype.CF: Unknown value 99",
-		adsbtype.DF(99): "adsbtype.DF: Unknown value 99",
-		adsbtype.DR(99): "adsbtype.DR: Unknown value 99",
-		adsbtype.FS(99): "adsbtype.FS: Unknown value 99",
-		adsbtype.RI(99): "adsbtype.RI: Unknown value 99",
-		adsbtype.SL(99): "adsbtype.SL: Unknown value 99",
-		adsbtype.VS(99): "adsbtype.VS: Unknown value 99",
+		adsbtype.AltEnc(99): "adsbtype.AltEnc: Unknown value 99",
+		adsbtype.CA(99):     "adsbtype.CA: Unknown value 99",
+		adsbtype.CC(99):     "adsbtype.CC: Unknown value 99",
+		adsbtype.CF(99):     "adsbtype.CF: Unknown value 99",
+		adsbtype.DF(99):     "adsbtype.DF: Unknown value 99",
+		adsbtype.DR(99):     "adsbtype.DR: Unknown value 99",
+		adsbtype.FS(99):     "adsbtype.FS: Unknown value 99",
+		adsbtype.RI(99):     "adsbtype.RI: Unknown value 99",
+		adsbtype.SL(99):     "adsbtype.SL: Unknown value 99",
+		adsbtype.VS(99):     "adsbtype.VS: Unknown value 99",
 
-		adsbtype.ATS(99):   "adsbtype.ATS: Unknown value 99",
-		adsbtype.BDS(0x99): "adsbtype.BDS: Unknown value 99",
-		adsbtype.SSS(99):   "adsbtype.SSS: Unknown value 99",
-		adsbtype.TRS(99):   "adsbtype.TRS: Unknown value 99",
+		adsbtype.ATS(99):    "adsbtype.ATS: Unknown value 99",
+		adsbtype.AltSrc(99): "adsbtype.AltSrc: Unknown value 99",
+		adsbtype.BDS(0x99):  "adsbtype.BDS: Unknown value 99",
+		adsbtype.FOM(99):    "adsbtype.FOM: Unknown value 99",
+		adsbtype.HZD(99):    "adsbtype.HZD: Unknown value 99",
+		adsbtype.SSS(99):    "adsbtype.SSS: Unknown value 99",
+		adsbtype.TRS(99):    "adsbtype.TRS: Unknown value 99",
 
-		adsbtype.TYPE(99): "adsbtype.TYPE: Unknown value 99",
+		adsbtype.TYPE(99):    "adsbtype.TYPE: Unknown value 99",
+		adsbtype.AcCat("D3"): "adsbtype.AcCat: Unknown value D3",
+		adsbtype.WTC("J"):    "adsbtype.WTC: Unknown value J",
+		adsbtype.SRC(99):     "adsbtype.SRC: Unknown value 99",
 	} {
 		result := fmt.Sprintf("%T: %s", val, val)
 		if result != out {
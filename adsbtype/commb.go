@@ -49,6 +49,33 @@ func (c ATS) String() string {
 	return fmt.Sprintf("Unknown value %d", c)
 }
 
+// AltSrc is the target altitude source.
+type AltSrc uint64
+
+// Target altitude source values.
+const (
+	AltSrc0 AltSrc = 0 // Unknown
+	AltSrc1 AltSrc = 1 // Aircraft altitude
+	AltSrc2 AltSrc = 2 // FCU / MCP selected altitude
+	AltSrc3 AltSrc = 3 // FMS selected altitude
+)
+
+var mAltSrc = map[AltSrc]string{
+	AltSrc0: "Unknown",
+	AltSrc1: "Aircraft altitude",
+	AltSrc2: "FCU / MCP selected altitude",
+	AltSrc3: "FMS selected altitude",
+}
+
+// String representation of AltSrc.
+func (c AltSrc) String() string {
+	if str, ok := mAltSrc[c]; ok {
+		return str
+	}
+
+	return fmt.Sprintf("Unknown value %d", c)
+}
+
 // BDS is the Comm-B data selector.
 type BDS uint64
 
@@ -169,6 +196,62 @@ func (c BDS) String() string {
 	return fmt.Sprintf("Unknown value %02x", uint64(c))
 }
 
+// FOM is the meteorological report figure of merit / source.
+type FOM uint64
+
+// Figure of merit / source values.
+const (
+	FOM0 FOM = 0 // Invalid
+	FOM1 FOM = 1 // INS
+	FOM2 FOM = 2 // GNSS
+	FOM3 FOM = 3 // DME / DME
+	FOM4 FOM = 4 // VOR / DME
+)
+
+var mFOM = map[FOM]string{
+	FOM0: "Invalid",
+	FOM1: "INS",
+	FOM2: "GNSS",
+	FOM3: "DME / DME",
+	FOM4: "VOR / DME",
+}
+
+// String representation of FOM.
+func (c FOM) String() string {
+	if str, ok := mFOM[c]; ok {
+		return str
+	}
+
+	return fmt.Sprintf("Unknown value %d", c)
+}
+
+// HZD is the meteorological hazard level.
+type HZD uint64
+
+// Hazard level values.
+const (
+	HZD0 HZD = 0 // Nil
+	HZD1 HZD = 1 // Light
+	HZD2 HZD = 2 // Moderate
+	HZD3 HZD = 3 // Severe
+)
+
+var mHZD = map[HZD]string{
+	HZD0: "Nil",
+	HZD1: "Light",
+	HZD2: "Moderate",
+	HZD3: "Severe",
+}
+
+// String representation of HZD.
+func (c HZD) String() string {
+	if str, ok := mHZD[c]; ok {
+		return str
+	}
+
+	return fmt.Sprintf("Unknown value %d", c)
+}
+
 // SSS is the surveillance status subfield.
 type SSS uint64
 
@@ -55,6 +55,7 @@ const (
 	TYPE21 TYPE = 21 // Airborne position, 25 meter, GNSS height
 	TYPE22 TYPE = 22 // Airborne position, GNSS height
 	TYPE28 TYPE = 28 // Emergency priority status
+	TYPE29 TYPE = 29 // Target state and status
 	TYPE31 TYPE = 31 // Operational status
 )
 
@@ -83,6 +84,7 @@ var mTYPE = map[TYPE]string{
 	TYPE21: "Airborne position, 25 meter, GNSS height",
 	TYPE22: "Airborne position, GNSS height",
 	TYPE28: "Emergency priority status",
+	TYPE29: "Target state and status",
 	TYPE31: "Operational status",
 }
 
@@ -95,6 +97,41 @@ func (c TYPE) String() string {
 	return fmt.Sprintf("Unknown value %d", c)
 }
 
+// EPS is the extended squitter emergency / priority status.
+type EPS uint64
+
+// Emergency / Priority Status values.
+const (
+	EPS0 EPS = 0 // No emergency
+	EPS1 EPS = 1 // General emergency
+	EPS2 EPS = 2 // Lifeguard / medical emergency
+	EPS3 EPS = 3 // Minimum fuel
+	EPS4 EPS = 4 // No communications
+	EPS5 EPS = 5 // Unlawful interference
+	EPS6 EPS = 6 // Downed aircraft
+	EPS7 EPS = 7 // Reserved
+)
+
+var mEPS = map[EPS]string{
+	EPS0: "No emergency",
+	EPS1: "General emergency",
+	EPS2: "Lifeguard / medical emergency",
+	EPS3: "Minimum fuel",
+	EPS4: "No communications",
+	EPS5: "Unlawful interference",
+	EPS6: "Downed aircraft",
+	EPS7: "Reserved",
+}
+
+// String representation of EPS.
+func (c EPS) String() string {
+	if str, ok := mEPS[c]; ok {
+		return str
+	}
+
+	return fmt.Sprintf("Unknown value %d", c)
+}
+
 // AcCat is the extended squitter aircraft emitter category.
 type AcCat string
 
@@ -163,3 +200,60 @@ func (c AcCat) String() string {
 
 	return "Unknown value " + string(c)
 }
+
+// WTC is the wake turbulence category.
+type WTC string
+
+// Wake turbulence category values.
+const (
+	WTCL WTC = "L" // Light (< 7000 kg)
+	WTCM WTC = "M" // Medium (7000 to 136000 kg)
+	WTCH WTC = "H" // Heavy (> 136000 kg)
+)
+
+var mWTC = map[WTC]string{
+	WTCL: "Light (< 7000 kg)",
+	WTCM: "Medium (7000 to 136000 kg)",
+	WTCH: "Heavy (> 136000 kg)",
+}
+
+// String representation of WTC.
+func (c WTC) String() string {
+	if str, ok := mWTC[c]; ok {
+		return str
+	}
+
+	return "Unknown value " + string(c)
+}
+
+// SRC is the extended squitter source type, derived from the downlink
+// format and control field.
+type SRC uint64
+
+// Source type values.
+const (
+	SRC0 SRC = 0 // ADS-B message, transponder
+	SRC1 SRC = 1 // ADS-B message, non-transponder device
+	SRC2 SRC = 2 // Fine format TIS-B message
+	SRC3 SRC = 3 // Coarse format TIS-B message
+	SRC4 SRC = 4 // TIS-B or ADS-R management message
+	SRC5 SRC = 5 // ADS-R rebroadcast message
+)
+
+var mSRC = map[SRC]string{
+	SRC0: "ADS-B message, transponder",
+	SRC1: "ADS-B message, non-transponder device",
+	SRC2: "Fine format TIS-B message",
+	SRC3: "Coarse format TIS-B message",
+	SRC4: "TIS-B or ADS-R management message",
+	SRC5: "ADS-R rebroadcast message",
+}
+
+// String representation of SRC.
+func (c SRC) String() string {
+	if str, ok := mSRC[c]; ok {
+		return str
+	}
+
+	return fmt.Sprintf("Unknown value %d", c)
+}
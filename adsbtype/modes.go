@@ -26,6 +26,31 @@ import (
 	"fmt"
 )
 
+// AltEnc is the encoding of an altitude field.
+type AltEnc uint64
+
+// Altitude encoding values.
+const (
+	AltEnc0 AltEnc = 0 // 25 ft increments
+	AltEnc1 AltEnc = 1 // 100 ft increments, Gillham code
+	AltEnc2 AltEnc = 2 // Metric
+)
+
+var mAltEnc = map[AltEnc]string{
+	AltEnc0: "25 ft increments",
+	AltEnc1: "100 ft increments, Gillham code",
+	AltEnc2: "Metric",
+}
+
+// String representation of AltEnc.
+func (c AltEnc) String() string {
+	if str, ok := mAltEnc[c]; ok {
+		return str
+	}
+
+	return fmt.Sprintf("Unknown value %d", c)
+}
+
 // CA is the capability.
 type CA uint64
 
@@ -0,0 +1,157 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package met
+
+import (
+	"math"
+	"time"
+)
+
+// Physical constants used in the estimates.
+const (
+	ktToMs = 1852.0 / 3600 // meters per second per knot
+	gamma  = 1.4           // ratio of specific heats of dry air
+	rAir   = 287.05287     // specific gas constant of dry air in J/(kg K)
+	kelvin = 273.15        // 0 degrees Celsius in kelvin
+)
+
+// Limits applied to the estimates.
+const (
+	maxRoll = 5.0   // roll angle in degrees above which the aircraft is turning
+	minMach = 0.5   // Mach number below which temperature is imprecise
+	minTemp = -90.0 // lowest plausible temperature in degrees Celsius
+	maxTemp = 60.0  // highest plausible temperature in degrees Celsius
+)
+
+// Observation is a set of time-correlated values reported by a single
+// aircraft. Values which are not available are indicated by the
+// corresponding Valid field being false.
+type Observation struct {
+	Heading  float64 // magnetic heading in degrees
+	HdgValid bool    // heading is available
+
+	Declination float64 // magnetic declination in degrees, positive east
+	DeclValid   bool    // declination is available
+
+	TAS      float64 // true airspeed in knots
+	TASValid bool    // true airspeed is available
+
+	Mach      float64 // Mach number
+	MachValid bool    // Mach number is available
+
+	GroundSpeed float64 // ground speed in knots
+	Track       float64 // true ground track in degrees
+	GSValid     bool    // ground speed and track are available
+
+	Roll      float64 // roll angle in degrees
+	RollValid bool    // roll angle is available
+
+	Altitude int64 // barometric altitude in feet
+	AltValid bool  // altitude is available
+}
+
+// Estimate is a wind and temperature estimate. Values which could not be
+// estimated are indicated by the corresponding Valid field being false.
+type Estimate struct {
+	ICAO uint64    // aircraft address, set by Estimator
+	Time time.Time // time of the estimate, set by Estimator
+
+	WindSpeed float64 // wind speed in knots
+	WindDir   float64 // direction the wind is blowing from in degrees true
+	WindValid bool    // wind is available
+
+	Temp      float64 // static air temperature in degrees Celsius
+	TempValid bool    // temperature is available
+
+	Altitude int64 // barometric altitude in feet
+	AltValid bool  // altitude is available
+
+	Turning  bool // roll angle exceeded 5 degrees, wind is less accurate
+	Magnetic bool // heading was not corrected for declination, wind is less accurate
+	LowMach  bool // Mach number was below 0.5, temperature is less accurate
+}
+
+// Estimate returns the wind and temperature estimated from the
+// observation. Wind requires heading, true airspeed, ground speed and
+// track. Temperature requires true airspeed and Mach number. An error
+// wrapping ErrNoData is returned if neither can be estimated.
+func (o *Observation) Estimate() (*Estimate, error) {
+	e := new(Estimate)
+
+	e.Altitude = o.Altitude
+	e.AltValid = o.AltValid
+
+	if o.HdgValid && o.TASValid && o.GSValid {
+		o.estimateWind(e)
+	}
+
+	if o.TASValid && o.MachValid && o.Mach > 0 {
+		o.estimateTemp(e)
+	}
+
+	if !e.WindValid && !e.TempValid {
+		return nil, newError(ErrNoData, "error estimating weather")
+	}
+
+	return e, nil
+}
+
+// estimateWind calculates the wind as the ground velocity vector minus
+// the air velocity vector.
+func (o *Observation) estimateWind(e *Estimate) {
+	hdg := o.Heading
+
+	if o.DeclValid {
+		hdg += o.Declination
+	} else {
+		e.Magnetic = true
+	}
+
+	hdg *= math.Pi / 180
+	trk := o.Track * math.Pi / 180
+
+	// wind vector components in the direction the wind is blowing
+	east := o.GroundSpeed*math.Sin(trk) - o.TAS*math.Sin(hdg)
+	north := o.GroundSpeed*math.Cos(trk) - o.TAS*math.Cos(hdg)
+
+	e.WindSpeed = math.Hypot(east, north)
+	e.WindDir = math.Mod(math.Atan2(-east, -north)*180/math.Pi+360, 360)
+	e.WindValid = true
+
+	e.Turning = o.RollValid && math.Abs(o.Roll) > maxRoll
+}
+
+// estimateTemp calculates the static air temperature from the speed of
+// sound given by true airspeed divided by Mach number.
+func (o *Observation) estimateTemp(e *Estimate) {
+	a := o.TAS * ktToMs / o.Mach
+
+	t := a*a/(gamma*rAir) - kelvin
+	if t < minTemp || t > maxTemp {
+		return
+	}
+
+	e.Temp = t
+	e.TempValid = true
+	e.LowMach = o.Mach < minMach
+}
@@ -0,0 +1,193 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package met_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"kreklow.us/go/go-adsb/met"
+)
+
+// TestEstimate runs the test cases for estimates from observations.
+func TestEstimate(t *testing.T) {
+	t.Run("Complete", testEstimateComplete)
+	t.Run("Magnetic", testEstimateMagnetic)
+	t.Run("Turning", testEstimateTurning)
+	t.Run("LowMach", testEstimateLowMach)
+	t.Run("WindOnly", testEstimateWindOnly)
+	t.Run("Implausible", testEstimateImplausible)
+	t.Run("NoData", testEstimateNoData)
+}
+
+// test a cruising aircraft with all values present.
+func testEstimateComplete(t *testing.T) {
+	testEstimate(t, &met.Observation{
+		Heading:     80,
+		HdgValid:    true,
+		Declination: 10,
+		DeclValid:   true,
+		TAS:         460,
+		TASValid:    true,
+		Mach:        0.78,
+		MachValid:   true,
+		GroundSpeed: 500,
+		Track:       90,
+		GSValid:     true,
+		Roll:        1,
+		RollValid:   true,
+		Altitude:    35000,
+		AltValid:    true,
+	}, &met.Estimate{
+		WindSpeed: 40,
+		WindDir:   270,
+		WindValid: true,
+		Temp:      -44.1,
+		TempValid: true,
+		Altitude:  35000,
+		AltValid:  true,
+	})
+}
+
+// test a heading without a declination correction.
+func testEstimateMagnetic(t *testing.T) {
+	testEstimate(t, &met.Observation{
+		Heading:     0,
+		HdgValid:    true,
+		TAS:         300,
+		TASValid:    true,
+		GroundSpeed: 320,
+		Track:       0,
+		GSValid:     true,
+	}, &met.Estimate{
+		WindSpeed: 20,
+		WindDir:   180,
+		WindValid: true,
+		Magnetic:  true,
+	})
+}
+
+// test a crosswind during a turn.
+func testEstimateTurning(t *testing.T) {
+	testEstimate(t, &met.Observation{
+		Heading:     0,
+		HdgValid:    true,
+		DeclValid:   true,
+		TAS:         300,
+		TASValid:    true,
+		GroundSpeed: math.Hypot(300, 30),
+		Track:       math.Atan2(30, 300) * 180 / math.Pi,
+		GSValid:     true,
+		Roll:        -25,
+		RollValid:   true,
+	}, &met.Estimate{
+		WindSpeed: 30,
+		WindDir:   270,
+		WindValid: true,
+		Turning:   true,
+	})
+}
+
+// test a temperature at low Mach number.
+func testEstimateLowMach(t *testing.T) {
+	testEstimate(t, &met.Observation{
+		TAS:       200,
+		TASValid:  true,
+		Mach:      0.304,
+		MachValid: true,
+	}, &met.Estimate{
+		Temp:      11.9,
+		TempValid: true,
+		LowMach:   true,
+	})
+}
+
+// test a wind without Mach number.
+func testEstimateWindOnly(t *testing.T) {
+	testEstimate(t, &met.Observation{
+		Heading:     180,
+		HdgValid:    true,
+		DeclValid:   true,
+		TAS:         250,
+		TASValid:    true,
+		GroundSpeed: 200,
+		Track:       180,
+		GSValid:     true,
+	}, &met.Estimate{
+		WindSpeed: 50,
+		WindDir:   180,
+		WindValid: true,
+	})
+}
+
+// test a temperature outside of the plausible range.
+func testEstimateImplausible(t *testing.T) {
+	e, err := (&met.Observation{
+		TAS:       450,
+		TASValid:  true,
+		Mach:      0.1,
+		MachValid: true,
+	}).Estimate()
+	if !errors.Is(err, met.ErrNoData) {
+		t.Error("received unexpected error", err)
+	}
+
+	if e != nil {
+		t.Error("received unexpected data")
+	}
+}
+
+// test an observation without sufficient values.
+func testEstimateNoData(t *testing.T) {
+	e, err := (&met.Observation{
+		Heading:  90,
+		HdgValid: true,
+		TAS:      450,
+		TASValid: true,
+	}).Estimate()
+	if err == nil || err.Error() != "error estimating weather: data not available" {
+		t.Error("received unexpected error", err)
+	}
+
+	if e != nil {
+		t.Error("received unexpected data")
+	}
+}
+
+func testEstimate(t *testing.T, o *met.Observation, exp *met.Estimate) {
+	t.Helper()
+
+	e, err := o.Estimate()
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	e.WindSpeed = math.Round(e.WindSpeed*10) / 10
+	e.WindDir = math.Round(e.WindDir*10) / 10
+	e.Temp = math.Round(e.Temp*10) / 10
+
+	if *e != *exp {
+		t.Errorf("received %+v, expected %+v", *e, *exp)
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package met
+
+import (
+	"time"
+
+	"kreklow.us/go/go-adsb/adsb"
+	"kreklow.us/go/go-adsb/adsbtype"
+)
+
+// Estimator collects decoded reports for each aircraft address and
+// produces wind and temperature estimates from the most recent reports.
+// Reports older than the maximum age are not combined into an estimate.
+// An Estimator is not safe for concurrent use.
+type Estimator struct {
+	maxAge   time.Duration
+	decl     float64
+	declSet  bool
+	aircraft map[uint64]*aircraft
+}
+
+// aircraft holds the most recent reports received from an aircraft.
+type aircraft struct {
+	tt     *adsb.TrackTurn    // register 5,0 report
+	ttTime time.Time          // time of the register 5,0 report
+	hs     *adsb.HeadingSpeed // register 6,0 report
+	hsTime time.Time          // time of the register 6,0 report
+	vel    *adsb.Velocity     // extended squitter velocity
+	vTime  time.Time          // time of the extended squitter velocity
+	alt    int64              // barometric altitude in feet
+	aTime  time.Time          // time of the altitude
+}
+
+// NewEstimator returns an Estimator which combines reports received
+// within maxAge of the time of an estimate.
+func NewEstimator(maxAge time.Duration) *Estimator {
+	return &Estimator{
+		maxAge:   maxAge,
+		aircraft: make(map[uint64]*aircraft),
+	}
+}
+
+// SetDeclination sets the magnetic declination in degrees, positive
+// east, used to convert reported magnetic headings to true headings.
+// Without a declination, estimates are flagged as Magnetic.
+func (e *Estimator) SetDeclination(deg float64) {
+	e.decl = deg
+	e.declSet = true
+}
+
+// AddTrackTurn stores a register 5,0 report received at time t.
+func (e *Estimator) AddTrackTurn(icao uint64, t time.Time, r *adsb.TrackTurn) {
+	a := e.get(icao)
+	a.tt = r
+	a.ttTime = t
+}
+
+// AddHeadingSpeed stores a register 6,0 report received at time t.
+func (e *Estimator) AddHeadingSpeed(icao uint64, t time.Time, r *adsb.HeadingSpeed) {
+	a := e.get(icao)
+	a.hs = r
+	a.hsTime = t
+}
+
+// AddVelocity stores an extended squitter velocity received at time t.
+// Velocities without ground speed and track are ignored.
+func (e *Estimator) AddVelocity(icao uint64, t time.Time, v *adsb.Velocity) {
+	if !v.GSValid {
+		return
+	}
+
+	a := e.get(icao)
+	a.vel = v
+	a.vTime = t
+}
+
+// AddAltitude stores a barometric altitude in feet received at time t.
+func (e *Estimator) AddAltitude(icao uint64, t time.Time, alt int64) {
+	a := e.get(icao)
+	a.alt = alt
+	a.aTime = t
+}
+
+// Add stores the reports contained in a message received at time t.
+// Altitude and extended squitter velocity are stored when available.
+// The register contained in DF20 and DF21 replies is inferred using the
+// reports already stored for the aircraft, and registers 5,0 and 6,0
+// are stored. Messages without usable reports are ignored.
+func (e *Estimator) Add(m *adsb.Message, t time.Time) error {
+	icao, err := m.ICAO()
+	if err != nil {
+		return newError(err, "error adding message")
+	}
+
+	if alt, err := m.Altitude(); err == nil && alt.Type == adsbtype.ATS0 {
+		e.AddAltitude(icao, t, alt.Feet)
+	}
+
+	if v, err := m.Velocity(); err == nil {
+		e.AddVelocity(icao, t, v)
+	}
+
+	if match, err := m.InferBDS(e.context(icao, t)); err == nil {
+		mb, _ := m.Raw().MB()
+		e.addCommB(icao, t, match.BDS, mb)
+	}
+
+	return nil
+}
+
+// addCommB stores a register 5,0 or 6,0 report from an MB field.
+func (e *Estimator) addCommB(icao uint64, t time.Time, bds adsbtype.BDS, mb uint64) {
+	switch bds {
+	case adsbtype.BDS50:
+		if r, err := adsb.DecodeBDS50(mb); err == nil {
+			e.AddTrackTurn(icao, t, r)
+		}
+	case adsbtype.BDS60:
+		if r, err := adsb.DecodeBDS60(mb); err == nil {
+			e.AddHeadingSpeed(icao, t, r)
+		}
+	}
+}
+
+// Estimate returns the wind and temperature estimate for an aircraft at
+// time t. An error wrapping ErrNoData is returned if the recent reports
+// are not sufficient to produce an estimate.
+func (e *Estimator) Estimate(icao uint64, t time.Time) (*Estimate, error) {
+	est, err := e.observation(icao, t).Estimate()
+	if err != nil {
+		return nil, newErrorf(ErrNoData, "error estimating weather for %06x", icao)
+	}
+
+	est.ICAO = icao
+	est.Time = t
+
+	return est, nil
+}
+
+// Expire discards the reports of aircraft which have not reported
+// within the maximum age as of time t.
+func (e *Estimator) Expire(t time.Time) {
+	for icao, a := range e.aircraft {
+		if !e.fresh(a.ttTime, t) && !e.fresh(a.hsTime, t) &&
+			!e.fresh(a.vTime, t) && !e.fresh(a.aTime, t) {
+			delete(e.aircraft, icao)
+		}
+	}
+}
+
+// get returns the reports for an aircraft, adding it if necessary.
+func (e *Estimator) get(icao uint64) *aircraft {
+	a, ok := e.aircraft[icao]
+	if !ok {
+		a = new(aircraft)
+		e.aircraft[icao] = a
+	}
+
+	return a
+}
+
+// fresh reports whether a report received at time r is within the
+// maximum age of time t.
+func (e *Estimator) fresh(r time.Time, t time.Time) bool {
+	if r.IsZero() {
+		return false
+	}
+
+	d := t.Sub(r)
+
+	return d <= e.maxAge && d >= -e.maxAge
+}
+
+// observation combines the recent reports for an aircraft.
+func (e *Estimator) observation(icao uint64, t time.Time) *Observation {
+	o := new(Observation)
+
+	o.Declination = e.decl
+	o.DeclValid = e.declSet
+
+	a, ok := e.aircraft[icao]
+	if !ok {
+		return o
+	}
+
+	if e.fresh(a.hsTime, t) {
+		o.Heading = a.hs.Heading
+		o.HdgValid = a.hs.HdgValid
+		o.Mach = a.hs.Mach
+		o.MachValid = a.hs.MachValid
+	}
+
+	if e.fresh(a.ttTime, t) {
+		o.TAS = float64(a.tt.TAS)
+		o.TASValid = a.tt.TASValid
+		o.Roll = a.tt.Roll
+		o.RollValid = a.tt.RollValid
+
+		if a.tt.GSValid && a.tt.TrackValid {
+			o.GroundSpeed = float64(a.tt.GroundSpeed)
+			o.Track = a.tt.Track
+			o.GSValid = true
+		}
+	}
+
+	// prefer the extended squitter velocity unless the register 5,0
+	// report is more recent
+	if e.fresh(a.vTime, t) && (!o.GSValid || !a.vTime.Before(a.ttTime)) {
+		o.GroundSpeed = a.vel.GroundSpeed
+		o.Track = a.vel.Track
+		o.GSValid = true
+	}
+
+	if e.fresh(a.aTime, t) {
+		o.Altitude = a.alt
+		o.AltValid = true
+	}
+
+	return o
+}
+
+// context returns the register inference context for an aircraft.
+func (e *Estimator) context(icao uint64, t time.Time) *adsb.BDSContext {
+	o := e.observation(icao, t)
+
+	return &adsb.BDSContext{
+		GroundSpeed: o.GroundSpeed,
+		GSValid:     o.GSValid,
+		Track:       o.Track,
+		TrkValid:    o.GSValid,
+		Heading:     o.Heading,
+		HdgValid:    o.HdgValid,
+	}
+}
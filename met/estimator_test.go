@@ -0,0 +1,217 @@
+// Copyright 2026 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package met_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"kreklow.us/go/go-adsb/adsb"
+	"kreklow.us/go/go-adsb/met"
+)
+
+// Registers 5,0 and 6,0 received from 4840d6 at 35000 feet.
+const (
+	metBDS50 = "a00016908014013ea004e6ee7b53"
+	metBDS60 = "a0001690a00a3130e00400ddc88a"
+)
+
+// TestEstimator runs the test cases for the Estimator.
+func TestEstimator(t *testing.T) {
+	t.Run("Messages", testEstimatorMessages)
+	t.Run("Velocity", testEstimatorVelocity)
+	t.Run("Stale", testEstimatorStale)
+	t.Run("Expire", testEstimatorExpire)
+	t.Run("NoData", testEstimatorNoData)
+}
+
+// test an estimate from Comm-B replies.
+func testEstimatorMessages(t *testing.T) {
+	e := met.NewEstimator(10 * time.Second)
+	e.SetDeclination(0)
+
+	ts := time.Unix(1000, 0)
+
+	testEstimatorAdd(t, e, metBDS50, ts)
+	testEstimatorAdd(t, e, metBDS60, ts.Add(time.Second))
+
+	testEstimator(t, e, ts.Add(2*time.Second), &met.Estimate{
+		ICAO:      0x4840d6,
+		Time:      ts.Add(2 * time.Second),
+		WindSpeed: 40,
+		WindDir:   270,
+		WindValid: true,
+		Temp:      -44.1,
+		TempValid: true,
+		Altitude:  35000,
+		AltValid:  true,
+	})
+}
+
+// test the preference for the most recent ground velocity.
+func testEstimatorVelocity(t *testing.T) {
+	e := met.NewEstimator(10 * time.Second)
+	e.SetDeclination(0)
+
+	ts := time.Unix(1000, 0)
+
+	e.AddHeadingSpeed(0x4840d6, ts, &adsb.HeadingSpeed{Heading: 90, HdgValid: true})
+	e.AddTrackTurn(0x4840d6, ts, &adsb.TrackTurn{
+		Track:       90,
+		TrackValid:  true,
+		GroundSpeed: 500,
+		GSValid:     true,
+		TAS:         460,
+		TASValid:    true,
+	})
+	e.AddVelocity(0x4840d6, ts.Add(time.Second), &adsb.Velocity{
+		GroundSpeed: 440,
+		Track:       90,
+		GSValid:     true,
+	})
+	e.AddVelocity(0x4840d6, ts.Add(2*time.Second), &adsb.Velocity{})
+
+	testEstimator(t, e, ts.Add(2*time.Second), &met.Estimate{
+		ICAO:      0x4840d6,
+		Time:      ts.Add(2 * time.Second),
+		WindSpeed: 20,
+		WindDir:   90,
+		WindValid: true,
+	})
+
+	e.AddTrackTurn(0x4840d6, ts.Add(3*time.Second), &adsb.TrackTurn{
+		Track:       90,
+		TrackValid:  true,
+		GroundSpeed: 500,
+		GSValid:     true,
+		TAS:         460,
+		TASValid:    true,
+	})
+
+	testEstimator(t, e, ts.Add(3*time.Second), &met.Estimate{
+		ICAO:      0x4840d6,
+		Time:      ts.Add(3 * time.Second),
+		WindSpeed: 40,
+		WindDir:   270,
+		WindValid: true,
+	})
+}
+
+// test reports older than the maximum age.
+func testEstimatorStale(t *testing.T) {
+	e := met.NewEstimator(10 * time.Second)
+
+	ts := time.Unix(1000, 0)
+
+	testEstimatorAdd(t, e, metBDS50, ts)
+	testEstimatorAdd(t, e, metBDS60, ts.Add(20*time.Second))
+
+	testEstimator(t, e, ts.Add(20*time.Second), nil)
+}
+
+// test discarding aircraft which have stopped reporting.
+func testEstimatorExpire(t *testing.T) {
+	e := met.NewEstimator(10 * time.Second)
+
+	ts := time.Unix(1000, 0)
+
+	testEstimatorAdd(t, e, metBDS50, ts)
+	testEstimatorAdd(t, e, metBDS60, ts)
+
+	e.Expire(ts.Add(5 * time.Second))
+
+	est, err := e.Estimate(0x4840d6, ts)
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	if !est.WindValid || !est.Magnetic {
+		t.Errorf("received %+v, expected magnetic wind", *est)
+	}
+
+	e.Expire(ts.Add(20 * time.Second))
+
+	testEstimator(t, e, ts, nil)
+}
+
+// test an unknown aircraft.
+func testEstimatorNoData(t *testing.T) {
+	e := met.NewEstimator(10 * time.Second)
+
+	est, err := e.Estimate(0xabcdef, time.Unix(1000, 0))
+	if err == nil || err.Error() != "error estimating weather for abcdef: data not available" {
+		t.Error("received unexpected error", err)
+	}
+
+	if est != nil {
+		t.Error("received unexpected data")
+	}
+}
+
+func testEstimatorAdd(t *testing.T, e *met.Estimator, msg string, ts time.Time) {
+	t.Helper()
+
+	m := new(adsb.Message)
+
+	err := m.UnmarshalText([]byte(msg))
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	err = e.Add(m, ts)
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+}
+
+func testEstimator(t *testing.T, e *met.Estimator, ts time.Time, exp *met.Estimate) {
+	t.Helper()
+
+	est, err := e.Estimate(0x4840d6, ts)
+
+	if exp == nil {
+		if !errors.Is(err, met.ErrNoData) {
+			t.Error("received unexpected error", err)
+		}
+
+		if est != nil {
+			t.Error("received unexpected data")
+		}
+
+		return
+	}
+
+	if err != nil {
+		t.Fatal("received unexpected error", err)
+	}
+
+	est.WindSpeed = math.Round(est.WindSpeed*10) / 10
+	est.WindDir = math.Round(est.WindDir*10) / 10
+	est.Temp = math.Round(est.Temp*10) / 10
+
+	if *est != *exp {
+		t.Errorf("received %+v, expected %+v", *est, *exp)
+	}
+}